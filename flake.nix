@@ -35,7 +35,7 @@
           version = self.shortRev or "dirty";
           src = self;
 
-          vendorHash = "sha256-KpWsBMBVGuzlKrMSjNcazAfIiiA1VINB5SiwfGgk+Gg=";
+          vendorHash = "sha256-EH5zY5pxwvVipmOFI5KENtBJFmSfcnZJLiE5oNUIYC0=";
 
           subPackages = ["."];
 
@@ -2,20 +2,29 @@ package cache
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net/mail"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
 
-	_ "modernc.org/sqlite"
+	"modernc.org/sqlite"
 )
 
 const schema = `
 CREATE TABLE IF NOT EXISTS mailing_lists (
 	name TEXT PRIMARY KEY,
 	category TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	post_address TEXT NOT NULL DEFAULT '',
 	updated_at INTEGER NOT NULL
 );
 
@@ -36,11 +45,61 @@ CREATE TABLE IF NOT EXISTS message_content (
 	date TEXT NOT NULL,
 	body TEXT NOT NULL,
 	headers TEXT NOT NULL,
+	message_id TEXT NOT NULL DEFAULT '',
+	fingerprint TEXT NOT NULL DEFAULT '',
 	updated_at INTEGER NOT NULL
 );
 
+CREATE TABLE IF NOT EXISTS message_source (
+	id TEXT PRIMARY KEY,
+	list TEXT NOT NULL,
+	source TEXT NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+
+-- Derived documents composed from cached messages, e.g. whole threads
+CREATE TABLE IF NOT EXISTS documents (
+	key TEXT PRIMARY KEY,
+	list TEXT NOT NULL,
+	content TEXT NOT NULL,
+	updated_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	created_at INTEGER NOT NULL,
+	tool TEXT NOT NULL,
+	arguments TEXT NOT NULL,
+	success INTEGER NOT NULL,
+	error TEXT NOT NULL,
+	duration_ms INTEGER NOT NULL
+);
+
+-- Future: summaries table
+CREATE TABLE IF NOT EXISTS summaries (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	message_id TEXT NOT NULL,
+	summary_type TEXT NOT NULL,
+	content TEXT NOT NULL,
+	model TEXT NOT NULL,
+	created_at INTEGER NOT NULL,
+	FOREIGN KEY (message_id) REFERENCES message_content(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_messages_list ON messages(list);
+CREATE INDEX IF NOT EXISTS idx_messages_date ON messages(date);
+CREATE INDEX IF NOT EXISTS idx_message_content_list ON message_content(list);
+CREATE INDEX IF NOT EXISTS idx_message_source_list ON message_source(list);
+CREATE INDEX IF NOT EXISTS idx_documents_list ON documents(list);
+CREATE INDEX IF NOT EXISTS idx_audit_log_created ON audit_log(created_at);
+CREATE INDEX IF NOT EXISTS idx_summaries_message ON summaries(message_id);
+`
+
+// ftsSchema is created separately so a SQLite build without the full-text
+// module degrades to LIKE scans instead of failing. %s is ftsModule.
+const ftsSchema = `
 -- FTS5 virtual table for full-text search
-CREATE VIRTUAL TABLE IF NOT EXISTS messages_fts USING fts5(
+CREATE VIRTUAL TABLE IF NOT EXISTS messages_fts USING %s(
 	id,
 	list,
 	subject,
@@ -67,34 +126,88 @@ CREATE TRIGGER IF NOT EXISTS messages_fts_update AFTER UPDATE ON message_content
 	INSERT INTO messages_fts(rowid, id, list, subject, author, body)
 	VALUES (NEW.rowid, NEW.id, NEW.list, NEW.subject, NEW.author, NEW.body);
 END;
-
--- Future: summaries table
-CREATE TABLE IF NOT EXISTS summaries (
-	id INTEGER PRIMARY KEY AUTOINCREMENT,
-	message_id TEXT NOT NULL,
-	summary_type TEXT NOT NULL,
-	content TEXT NOT NULL,
-	model TEXT NOT NULL,
-	created_at INTEGER NOT NULL,
-	FOREIGN KEY (message_id) REFERENCES message_content(id)
-);
-
-CREATE INDEX IF NOT EXISTS idx_messages_list ON messages(list);
-CREATE INDEX IF NOT EXISTS idx_messages_date ON messages(date);
-CREATE INDEX IF NOT EXISTS idx_message_content_list ON message_content(list);
-CREATE INDEX IF NOT EXISTS idx_summaries_message ON summaries(message_id);
 `
 
+// ftsModule is the virtual table module used for full-text search; tests
+// replace it to simulate a build without FTS5.
+var ftsModule = "fts5"
+
 type Cache struct {
-	db     *sql.DB
-	logger *slog.Logger
-	ttl    time.Duration
+	db      *sql.DB
+	logger  *slog.Logger
+	ttl     time.Duration
+	listTTL map[string]time.Duration
+	// fts reports whether messages_fts is available
+	fts bool
+	// readOnly turns every write into a logged no-op (Options.ReadOnly)
+	readOnly bool
 }
 
 type Options struct {
 	DBPath string
 	TTL    time.Duration
-	Logger *slog.Logger
+	// ListTTL overrides TTL for individual mailing lists, keyed by list name.
+	ListTTL map[string]time.Duration
+	// Pragmas are SQLite PRAGMA settings applied to every connection, e.g.
+	// {"cache_size": "-20000"}. Only keys in allowedPragmas are accepted.
+	Pragmas map[string]string
+	// ReadOnly opens an existing database without ever writing to it, e.g.
+	// a shared pre-populated cache mounted read-only. Writes, evictions
+	// and Cleanup are skipped with a debug log; the schema is not created
+	// or migrated, so the database must come from this version.
+	ReadOnly bool
+	Logger   *slog.Logger
+}
+
+// allowedPragmas are the performance pragmas operators may tune. Settings
+// that affect durability or integrity (journal_mode, synchronous,
+// foreign_keys, ...) are deliberately left out.
+var allowedPragmas = map[string]bool{
+	"cache_size":         true,
+	"mmap_size":          true,
+	"temp_store":         true,
+	"busy_timeout":       true,
+	"wal_autocheckpoint": true,
+	"journal_size_limit": true,
+}
+
+// pragmaValueRegex restricts pragma values to plain numbers and keywords so
+// they cannot smuggle anything into the connection string.
+var pragmaValueRegex = regexp.MustCompile(`^-?[0-9A-Za-z_]+$`)
+
+// dataSourceName appends pragmas to path as modernc.org/sqlite _pragma
+// parameters, which run on every new connection in the pool. A readOnly
+// database is opened with mode=ro and query_only set as well. Parameters
+// go in a file: URI with path escaped, so a "?" or "%" in path stays part
+// of the file name.
+func dataSourceName(path string, pragmas map[string]string, readOnly bool) (string, error) {
+	// The driver splits the name at the first "?" even without a file: URI
+	if len(pragmas) == 0 && !readOnly && !strings.Contains(path, "?") {
+		return path, nil
+	}
+
+	names := make([]string, 0, len(pragmas))
+	for name := range pragmas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make(url.Values)
+	for _, name := range names {
+		value := pragmas[name]
+		if !allowedPragmas[name] {
+			return "", fmt.Errorf("pragma %q is not allowed", name)
+		}
+		if !pragmaValueRegex.MatchString(value) {
+			return "", fmt.Errorf("invalid value %q for pragma %s", value, name)
+		}
+		params.Add("_pragma", name+"("+value+")")
+	}
+	if readOnly {
+		params.Set("mode", "ro")
+		params.Add("_pragma", "query_only(1)")
+	}
+	return "file:" + (&url.URL{Path: path}).EscapedPath() + "?" + params.Encode(), nil
 }
 
 func New(opts Options) (*Cache, error) {
@@ -114,11 +227,20 @@ func New(opts Options) (*Cache, error) {
 		opts.Logger = slog.New(slog.NewTextHandler(os.Stderr, nil))
 	}
 
+	if opts.ReadOnly {
+		return openReadOnly(opts)
+	}
+
 	if err := os.MkdirAll(filepath.Dir(opts.DBPath), 0755); err != nil {
 		return nil, fmt.Errorf("create cache dir: %w", err)
 	}
 
-	db, err := sql.Open("sqlite", opts.DBPath)
+	dsn, err := dataSourceName(opts.DBPath, opts.Pragmas, false)
+	if err != nil {
+		return nil, fmt.Errorf("cache pragmas: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("open database: %w", err)
 	}
@@ -134,30 +256,230 @@ func New(opts Options) (*Cache, error) {
 		return nil, fmt.Errorf("create schema: %w", err)
 	}
 
-	opts.Logger.Debug("cache initialized", "path", opts.DBPath, "ttl", opts.TTL)
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrate schema: %w", err)
+	}
+
+	fts := initFTS(db, opts.Logger)
+
+	opts.Logger.Debug("cache initialized", "path", opts.DBPath, "ttl", opts.TTL, "list_ttl", opts.ListTTL, "pragmas", opts.Pragmas, "fts", fts)
+
+	return &Cache{
+		db:      db,
+		logger:  opts.Logger,
+		ttl:     opts.TTL,
+		listTTL: opts.ListTTL,
+		fts:     fts,
+	}, nil
+}
+
+// openReadOnly is New for Options.ReadOnly: the database must already
+// exist, and full-text search is used when it has the index.
+func openReadOnly(opts Options) (*Cache, error) {
+	if _, err := os.Stat(opts.DBPath); err != nil {
+		return nil, fmt.Errorf("open read-only database: %w", err)
+	}
+
+	dsn, err := dataSourceName(opts.DBPath, opts.Pragmas, true)
+	if err != nil {
+		return nil, fmt.Errorf("cache pragmas: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+
+	var fts bool
+	err = db.QueryRow("SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE name = 'messages_fts')").Scan(&fts)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("open read-only database: %w", err)
+	}
+
+	opts.Logger.Debug("cache initialized read-only", "path", opts.DBPath, "ttl", opts.TTL, "list_ttl", opts.ListTTL, "pragmas", opts.Pragmas, "fts", fts)
 
 	return &Cache{
-		db:     db,
-		logger: opts.Logger,
-		ttl:    opts.TTL,
+		db:       db,
+		logger:   opts.Logger,
+		ttl:      opts.TTL,
+		listTTL:  opts.ListTTL,
+		fts:      fts,
+		readOnly: true,
 	}, nil
 }
 
+// skipWrite reports whether the cache is read-only, logging the write op
+// it then skips.
+func (c *Cache) skipWrite(op string) bool {
+	if c.readOnly {
+		c.logger.Debug("cache read-only, skipping write", "op", op)
+	}
+	return c.readOnly
+}
+
+// migrate brings databases created by older versions up to the current
+// schema.
+func migrate(db *sql.DB) error {
+	for _, column := range []string{"description", "post_address"} {
+		if _, err := ensureColumn(db, "mailing_lists", column, "TEXT NOT NULL DEFAULT ''"); err != nil {
+			return err
+		}
+	}
+
+	// Rows from before the fingerprint column get theirs on the next fetch
+	if _, err := ensureColumn(db, "message_content", "fingerprint", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	added, err := ensureColumn(db, "message_content", "message_id", "TEXT NOT NULL DEFAULT ''")
+	if err != nil {
+		return err
+	}
+	if added {
+		if err := backfillMessageIDs(db); err != nil {
+			return err
+		}
+	}
+
+	_, err = db.Exec("CREATE INDEX IF NOT EXISTS idx_message_content_message_id ON message_content(message_id)")
+	return err
+}
+
+// ensureColumn adds column to table unless it already exists, reporting
+// whether it was added.
+func ensureColumn(db *sql.DB, table, column, decl string) (bool, error) {
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return false, err
+		}
+		if name == column {
+			return false, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+	rows.Close()
+
+	if _, err := db.Exec("ALTER TABLE " + table + " ADD COLUMN " + column + " " + decl); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// backfillMessageIDs fills message_content.message_id from the stored
+// headers of rows written before the column existed.
+func backfillMessageIDs(db *sql.DB) error {
+	rows, err := db.Query("SELECT id, headers FROM message_content")
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]string)
+	for rows.Next() {
+		var id, headersJSON string
+		if err := rows.Scan(&id, &headersJSON); err != nil {
+			rows.Close()
+			return err
+		}
+		var headers map[string]string
+		if json.Unmarshal([]byte(headersJSON), &headers) != nil {
+			continue
+		}
+		if messageID := messageIDHeader(headers); messageID != "" {
+			ids[id] = messageID
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for id, messageID := range ids {
+		if _, err := db.Exec("UPDATE message_content SET message_id = ? WHERE id = ?", messageID, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// messageIDHeader returns the Message-ID header, matched case-insensitively.
+func messageIDHeader(headers map[string]string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, "Message-ID") {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+// initFTS creates the full-text index and reports whether it is usable.
+// Without it the sync triggers are dropped, since they would make every
+// message_content write fail.
+func initFTS(db *sql.DB, logger *slog.Logger) bool {
+	_, err := db.Exec(fmt.Sprintf(ftsSchema, ftsModule))
+	if err == nil {
+		return true
+	}
+
+	logger.Warn("full-text search unavailable, falling back to LIKE scans", "module", ftsModule, "error", err)
+
+	for _, trigger := range []string{"messages_fts_insert", "messages_fts_delete", "messages_fts_update"} {
+		if _, err := db.Exec("DROP TRIGGER IF EXISTS " + trigger); err != nil {
+			logger.Warn("failed to drop fts trigger", "trigger", trigger, "error", err)
+		}
+	}
+	return false
+}
+
+// ttlFor returns the freshness window for a list, falling back to the
+// global TTL when no override is configured.
+func (c *Cache) ttlFor(list string) time.Duration {
+	if ttl, ok := c.listTTL[list]; ok && ttl > 0 {
+		return ttl
+	}
+	return c.ttl
+}
+
+func (c *Cache) cutoffFor(list string) int64 {
+	return time.Now().Add(-c.ttlFor(list)).Unix()
+}
+
 func (c *Cache) Close() error {
 	return c.db.Close()
 }
 
+// WithLogger returns a Cache sharing c's database that logs to logger,
+// e.g. a request-scoped one. Close the original, not the copy.
+func (c *Cache) WithLogger(logger *slog.Logger) *Cache {
+	scoped := *c
+	scoped.logger = logger
+	return &scoped
+}
+
 type MailingList struct {
 	Name     string
 	Category string
+	// Description and PostAddress are empty when marc.info shows none.
+	Description string
+	PostAddress string
 }
 
+// GetMailingLists returns the cached catalog. It is a miss as soon as any
+// list has outlived its TTL (see Options.ListTTL), so a partly expired
+// catalog is never served.
 func (c *Cache) GetMailingLists() ([]MailingList, bool) {
-	cutoff := time.Now().Add(-c.ttl).Unix()
-
 	rows, err := c.db.Query(
-		"SELECT name, category FROM mailing_lists WHERE updated_at > ? ORDER BY category, name",
-		cutoff,
+		"SELECT name, category, description, post_address, updated_at FROM mailing_lists ORDER BY category, name",
 	)
 	if err != nil {
 		c.logger.Debug("cache miss: mailing_lists", "error", err)
@@ -168,7 +490,13 @@ func (c *Cache) GetMailingLists() ([]MailingList, bool) {
 	var lists []MailingList
 	for rows.Next() {
 		var l MailingList
-		if err := rows.Scan(&l.Name, &l.Category); err != nil {
+		var updatedAt int64
+		if err := rows.Scan(&l.Name, &l.Category, &l.Description, &l.PostAddress, &updatedAt); err != nil {
+			return nil, false
+		}
+
+		if updatedAt <= c.cutoffFor(l.Name) {
+			c.logger.Debug("cache miss: mailing_lists", "expired", l.Name)
 			return nil, false
 		}
 		lists = append(lists, l)
@@ -182,17 +510,70 @@ func (c *Cache) GetMailingLists() ([]MailingList, bool) {
 	return lists, true
 }
 
+// GetListCategory returns the category of a single cached mailing list.
+func (c *Cache) GetListCategory(name string) (string, bool) {
+	var category string
+	err := c.db.QueryRow(
+		"SELECT category FROM mailing_lists WHERE name = ? AND updated_at > ?",
+		name, c.cutoffFor(name),
+	).Scan(&category)
+
+	if err != nil {
+		c.logger.Debug("cache miss: mailing_lists", "name", name, "error", err)
+		return "", false
+	}
+
+	c.logger.Debug("cache hit: mailing_lists", "name", name)
+	return category, true
+}
+
+// GetStaleMailingLists returns every cached mailing list regardless of age.
+func (c *Cache) GetStaleMailingLists() ([]MailingList, bool) {
+	rows, err := c.db.Query("SELECT name, category, description, post_address FROM mailing_lists ORDER BY category, name")
+	if err != nil {
+		c.logger.Debug("cache miss: stale mailing_lists", "error", err)
+		return nil, false
+	}
+	defer rows.Close()
+
+	var lists []MailingList
+	for rows.Next() {
+		var l MailingList
+		if err := rows.Scan(&l.Name, &l.Category, &l.Description, &l.PostAddress); err != nil {
+			return nil, false
+		}
+		lists = append(lists, l)
+	}
+
+	if len(lists) == 0 {
+		return nil, false
+	}
+
+	c.logger.Debug("cache hit: stale mailing_lists", "count", len(lists))
+	return lists, true
+}
+
 func (c *Cache) SetMailingLists(lists []MailingList) error {
+	if c.skipWrite("mailing_lists") {
+		return nil
+	}
+
 	tx, err := c.db.Begin()
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
+	// The lists replace the whole catalog, so lists dropped from the
+	// index do not linger
+	if _, err := tx.Exec("DELETE FROM mailing_lists"); err != nil {
+		return err
+	}
+
 	now := time.Now().Unix()
 
 	stmt, err := tx.Prepare(
-		"INSERT OR REPLACE INTO mailing_lists (name, category, updated_at) VALUES (?, ?, ?)",
+		"INSERT OR REPLACE INTO mailing_lists (name, category, description, post_address, updated_at) VALUES (?, ?, ?, ?, ?)",
 	)
 	if err != nil {
 		return err
@@ -200,7 +581,7 @@ func (c *Cache) SetMailingLists(lists []MailingList) error {
 	defer stmt.Close()
 
 	for _, l := range lists {
-		if _, err := stmt.Exec(l.Name, l.Category, now); err != nil {
+		if _, err := stmt.Exec(l.Name, l.Category, l.Description, l.PostAddress, now); err != nil {
 			return err
 		}
 	}
@@ -218,8 +599,16 @@ type Message struct {
 }
 
 func (c *Cache) GetMessages(list, month string) ([]Message, bool) {
-	cutoff := time.Now().Add(-c.ttl).Unix()
+	return c.getMessages(list, month, c.cutoffFor(list))
+}
+
+// GetStaleMessages is GetMessages ignoring the TTL, returning whatever is
+// cached for the month however old it is.
+func (c *Cache) GetStaleMessages(list, month string) ([]Message, bool) {
+	return c.getMessages(list, month, 0)
+}
 
+func (c *Cache) getMessages(list, month string, cutoff int64) ([]Message, bool) {
 	query := "SELECT id, list, subject, author, date FROM messages WHERE list = ? AND updated_at > ?"
 	args := []any{list, cutoff}
 
@@ -257,6 +646,10 @@ func (c *Cache) GetMessages(list, month string) ([]Message, bool) {
 }
 
 func (c *Cache) SetMessages(messages []Message) error {
+	if c.skipWrite("messages") {
+		return nil
+	}
+
 	if len(messages) == 0 {
 		return nil
 	}
@@ -287,22 +680,142 @@ func (c *Cache) SetMessages(messages []Message) error {
 	return tx.Commit()
 }
 
+// CachedMonths returns the distinct YYYYMM months present in the messages
+// table for a list, sorted ascending. Expired rows are included since this
+// reports local coverage rather than freshness.
+func (c *Cache) CachedMonths(list string) ([]string, error) {
+	rows, err := c.db.Query(
+		`SELECT DISTINCT substr(date, 1, 4) || substr(date, 6, 2) AS month
+		FROM messages
+		WHERE list = ? AND date GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]*'
+		ORDER BY month`,
+		list,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cached months: %w", err)
+	}
+	defer rows.Close()
+
+	months := make([]string, 0)
+	for rows.Next() {
+		var m string
+		if err := rows.Scan(&m); err != nil {
+			return nil, err
+		}
+		months = append(months, m)
+	}
+
+	return months, rows.Err()
+}
+
+// CachedLists returns the names of lists with cached month listings,
+// sorted by name.
+func (c *Cache) CachedLists() ([]string, error) {
+	rows, err := c.db.Query("SELECT DISTINCT list FROM messages ORDER BY list")
+	if err != nil {
+		return nil, fmt.Errorf("cached lists: %w", err)
+	}
+	defer rows.Close()
+
+	lists := make([]string, 0)
+	for rows.Next() {
+		var l string
+		if err := rows.Scan(&l); err != nil {
+			return nil, err
+		}
+		lists = append(lists, l)
+	}
+
+	return lists, rows.Err()
+}
+
+// maxFacetEntries bounds each facet returned by Facets.
+const maxFacetEntries = 25
+
+// Facets holds per-author and per-month (YYYYMM) message counts of a list.
+type Facets struct {
+	ByAuthor map[string]int
+	ByMonth  map[string]int
+}
+
+// Facets counts the messages of a list's month listings by author and by
+// month, keeping the maxFacetEntries largest buckets of each (ties broken
+// by key). Like CachedMonths, expired rows are included.
+func (c *Cache) Facets(list string) (Facets, error) {
+	byAuthor, err := c.facet(
+		`SELECT author, COUNT(*) AS n FROM messages
+		WHERE list = ?
+		GROUP BY author
+		ORDER BY n DESC, author
+		LIMIT ?`,
+		list,
+	)
+	if err != nil {
+		return Facets{}, fmt.Errorf("author facet: %w", err)
+	}
+
+	byMonth, err := c.facet(
+		`SELECT substr(date, 1, 4) || substr(date, 6, 2) AS month, COUNT(*) AS n FROM messages
+		WHERE list = ? AND date GLOB '[0-9][0-9][0-9][0-9]-[0-9][0-9]*'
+		GROUP BY month
+		ORDER BY n DESC, month
+		LIMIT ?`,
+		list,
+	)
+	if err != nil {
+		return Facets{}, fmt.Errorf("month facet: %w", err)
+	}
+
+	c.logger.Debug("facets", "list", list, "authors", len(byAuthor), "months", len(byMonth))
+	return Facets{ByAuthor: byAuthor, ByMonth: byMonth}, nil
+}
+
+// facet runs a (key, count) query taking list and a row limit.
+func (c *Cache) facet(query, list string) (map[string]int, error) {
+	rows, err := c.db.Query(query, list, maxFacetEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var key string
+		var n int
+		if err := rows.Scan(&key, &n); err != nil {
+			return nil, err
+		}
+		counts[key] = n
+	}
+	return counts, rows.Err()
+}
+
 type MessageContent struct {
 	Message
 	Body    string
 	Headers map[string]string
+	// Fingerprint is the content hash computed by the client; empty for
+	// rows written before it was stored.
+	Fingerprint string
 }
 
 func (c *Cache) GetMessageContent(list, id string) (*MessageContent, bool) {
-	cutoff := time.Now().Add(-c.ttl).Unix()
+	return c.getMessageContent(list, id, c.cutoffFor(list))
+}
+
+// GetStaleMessageContent is GetMessageContent ignoring the TTL.
+func (c *Cache) GetStaleMessageContent(list, id string) (*MessageContent, bool) {
+	return c.getMessageContent(list, id, 0)
+}
 
+func (c *Cache) getMessageContent(list, id string, cutoff int64) (*MessageContent, bool) {
 	var m MessageContent
 	var headersJSON string
 
 	err := c.db.QueryRow(
-		"SELECT id, list, subject, author, date, body, headers FROM message_content WHERE id = ? AND list = ? AND updated_at > ?",
+		"SELECT id, list, subject, author, date, body, headers, fingerprint FROM message_content WHERE id = ? AND list = ? AND updated_at > ?",
 		id, list, cutoff,
-	).Scan(&m.ID, &m.List, &m.Subject, &m.Author, &m.Date, &m.Body, &headersJSON)
+	).Scan(&m.ID, &m.List, &m.Subject, &m.Author, &m.Date, &m.Body, &headersJSON, &m.Fingerprint)
 
 	if err != nil {
 		c.logger.Debug("cache miss: message_content", "id", id, "error", err)
@@ -318,6 +831,10 @@ func (c *Cache) GetMessageContent(list, id string) (*MessageContent, bool) {
 }
 
 func (c *Cache) SetMessageContent(m *MessageContent) error {
+	if c.skipWrite("message_content") {
+		return nil
+	}
+
 	headersJSON, err := json.Marshal(m.Headers)
 	if err != nil {
 		return err
@@ -326,8 +843,8 @@ func (c *Cache) SetMessageContent(m *MessageContent) error {
 	now := time.Now().Unix()
 
 	_, err = c.db.Exec(
-		"INSERT OR REPLACE INTO message_content (id, list, subject, author, date, body, headers, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
-		m.ID, m.List, m.Subject, m.Author, m.Date, m.Body, string(headersJSON), now,
+		"INSERT OR REPLACE INTO message_content (id, list, subject, author, date, body, headers, message_id, fingerprint, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		m.ID, m.List, m.Subject, m.Author, m.Date, m.Body, string(headersJSON), messageIDHeader(m.Headers), m.Fingerprint, now,
 	)
 
 	if err == nil {
@@ -337,10 +854,192 @@ func (c *Cache) SetMessageContent(m *MessageContent) error {
 	return err
 }
 
+func (c *Cache) GetMessageSource(list, id string) (string, bool) {
+	cutoff := c.cutoffFor(list)
+
+	var source string
+	err := c.db.QueryRow(
+		"SELECT source FROM message_source WHERE id = ? AND list = ? AND updated_at > ?",
+		id, list, cutoff,
+	).Scan(&source)
+
+	if err != nil {
+		c.logger.Debug("cache miss: message_source", "id", id, "error", err)
+		return "", false
+	}
+
+	c.logger.Debug("cache hit: message_source", "id", id)
+	return source, true
+}
+
+func (c *Cache) SetMessageSource(list, id, source string) error {
+	if c.skipWrite("message_source") {
+		return nil
+	}
+
+	now := time.Now().Unix()
+
+	_, err := c.db.Exec(
+		"INSERT OR REPLACE INTO message_source (id, list, source, updated_at) VALUES (?, ?, ?, ?)",
+		id, list, source, now,
+	)
+
+	if err == nil {
+		c.logger.Debug("cache set: message_source", "id", id)
+	}
+
+	return err
+}
+
+// GetDocument returns a composed document stored under key, subject to the
+// list's TTL.
+func (c *Cache) GetDocument(list, key string) (string, bool) {
+	cutoff := c.cutoffFor(list)
+
+	var content string
+	err := c.db.QueryRow(
+		"SELECT content FROM documents WHERE key = ? AND list = ? AND updated_at > ?",
+		key, list, cutoff,
+	).Scan(&content)
+
+	if err != nil {
+		c.logger.Debug("cache miss: documents", "key", key, "error", err)
+		return "", false
+	}
+
+	c.logger.Debug("cache hit: documents", "key", key)
+	return content, true
+}
+
+func (c *Cache) SetDocument(list, key, content string) error {
+	if c.skipWrite("documents") {
+		return nil
+	}
+
+	now := time.Now().Unix()
+
+	_, err := c.db.Exec(
+		"INSERT OR REPLACE INTO documents (key, list, content, updated_at) VALUES (?, ?, ?, ?)",
+		key, list, content, now,
+	)
+
+	if err == nil {
+		c.logger.Debug("cache set: documents", "key", key)
+	}
+
+	return err
+}
+
 // SearchMessages performs full-text search across cached messages
 func (c *Cache) SearchMessages(query string, list string) ([]Message, error) {
-	sqlQuery := `
-		SELECT mc.id, mc.list, mc.subject, mc.author, mc.date
+	return c.SearchMessagesWithOptions(SearchOptions{Query: query, List: list})
+}
+
+type SearchOptions struct {
+	Query string
+	// List restricts results to one list; ExcludeList drops one list.
+	// When both name the same list, List wins.
+	List        string
+	ExcludeList string
+	// Order is "desc" (newest first) or "asc" to sort the matches by date
+	// before the result limit applies; empty keeps the relevance order
+	// (recency without FTS).
+	Order string
+}
+
+func (c *Cache) SearchMessagesWithOptions(opts SearchOptions) ([]Message, error) {
+	query, list, exclude := opts.Query, opts.List, opts.ExcludeList
+	if exclude == list {
+		exclude = ""
+	}
+
+	if !c.fts {
+		return c.searchMessagesLike(query, list, exclude, opts.Order)
+	}
+
+	from, args := ftsSearchClause(query, list, exclude)
+	sqlQuery := "SELECT mc.id, mc.list, mc.subject, mc.author, mc.date " + from
+	if opts.Order != "" {
+		sqlQuery += orderByDate("mc.", opts.Order) + " LIMIT 100"
+	} else {
+		sqlQuery += " ORDER BY rank LIMIT 100"
+	}
+
+	rows, err := c.db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []Message
+	for rows.Next() {
+		var m Message
+		if err := rows.Scan(&m.ID, &m.List, &m.Subject, &m.Author, &m.Date); err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+
+	c.logger.Debug("fts search", "query", query, "results", len(messages))
+	return messages, nil
+}
+
+// searchMessagesLike is the SearchMessages fallback without FTS5.
+func (c *Cache) searchMessagesLike(query, list, exclude, order string) ([]Message, error) {
+	from, args := likeSearchClause(query, list, exclude)
+	sqlQuery := "SELECT id, list, subject, author, date " + from
+	if order != "" {
+		sqlQuery += orderByDate("", order) + " LIMIT 100"
+	} else {
+		sqlQuery += " ORDER BY updated_at DESC LIMIT 100"
+	}
+
+	rows, err := c.db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []Message
+	for rows.Next() {
+		var m Message
+		if err := rows.Scan(&m.ID, &m.List, &m.Subject, &m.Author, &m.Date); err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+
+	c.logger.Debug("like search", "query", query, "results", len(messages))
+	return messages, rows.Err()
+}
+
+// CountSearchMessages returns how many cached messages match opts, with
+// the same matching as SearchMessagesWithOptions but without its result
+// limit.
+func (c *Cache) CountSearchMessages(opts SearchOptions) (int, error) {
+	exclude := opts.ExcludeList
+	if exclude == opts.List {
+		exclude = ""
+	}
+
+	from, args := likeSearchClause(opts.Query, opts.List, exclude)
+	if c.fts {
+		from, args = ftsSearchClause(opts.Query, opts.List, exclude)
+	}
+
+	var n int
+	if err := c.db.QueryRow("SELECT COUNT(*) "+from, args...).Scan(&n); err != nil {
+		return 0, fmt.Errorf("count search failed: %w", err)
+	}
+
+	c.logger.Debug("search count", "query", opts.Query, "list", opts.List, "count", n)
+	return n, nil
+}
+
+// ftsSearchClause returns the FROM and WHERE clauses of an FTS5 search,
+// with message_content aliased as mc.
+func ftsSearchClause(query, list, exclude string) (string, []any) {
+	clause := `
 		FROM messages_fts fts
 		JOIN message_content mc ON fts.rowid = mc.rowid
 		WHERE messages_fts MATCH ?
@@ -348,36 +1047,305 @@ func (c *Cache) SearchMessages(query string, list string) ([]Message, error) {
 	args := []any{query}
 
 	if list != "" {
-		sqlQuery += " AND mc.list = ?"
+		clause += " AND mc.list = ?"
 		args = append(args, list)
 	}
+	if exclude != "" {
+		clause += " AND mc.list != ?"
+		args = append(args, exclude)
+	}
+	return clause, args
+}
 
-	sqlQuery += " ORDER BY rank LIMIT 100"
+// likeSearchClause returns the FROM and WHERE clauses of the search
+// fallback without FTS5: every word of query must appear in the subject,
+// author or body.
+func likeSearchClause(query, list, exclude string) (string, []any) {
+	clause := "FROM message_content WHERE 1 = 1"
+	var args []any
+
+	for _, word := range strings.Fields(query) {
+		pattern := "%" + escapeLike(strings.Trim(word, `"*`)) + "%"
+		clause += ` AND (subject LIKE ? ESCAPE '\' OR author LIKE ? ESCAPE '\' OR body LIKE ? ESCAPE '\')`
+		args = append(args, pattern, pattern, pattern)
+	}
 
-	rows, err := c.db.Query(sqlQuery, args...)
+	if list != "" {
+		clause += " AND list = ?"
+		args = append(args, list)
+	}
+	if exclude != "" {
+		clause += " AND list != ?"
+		args = append(args, exclude)
+	}
+	return clause, args
+}
+
+// CrossPost is a message fetched from more than one list, identified by
+// its Message-ID header.
+type CrossPost struct {
+	MessageID string
+	Messages  []Message
+}
+
+// FindCrossPosts groups fetched messages by Message-ID and returns the
+// groups that appear on more than one list, ordered by Message-ID. Messages
+// within a group are ordered by list.
+func (c *Cache) FindCrossPosts() ([]CrossPost, error) {
+	rows, err := c.db.Query(`
+		SELECT message_id, id, list, subject, author, date
+		FROM message_content
+		WHERE message_id IN (
+			SELECT message_id FROM message_content
+			WHERE message_id != ''
+			GROUP BY message_id
+			HAVING COUNT(DISTINCT list) > 1
+		)
+		ORDER BY message_id, list, id
+	`)
 	if err != nil {
-		return nil, fmt.Errorf("search failed: %w", err)
+		return nil, fmt.Errorf("find cross-posts: %w", err)
 	}
 	defer rows.Close()
 
-	var messages []Message
+	var posts []CrossPost
 	for rows.Next() {
+		var messageID string
 		var m Message
-		if err := rows.Scan(&m.ID, &m.List, &m.Subject, &m.Author, &m.Date); err != nil {
+		if err := rows.Scan(&messageID, &m.ID, &m.List, &m.Subject, &m.Author, &m.Date); err != nil {
 			return nil, err
 		}
-		messages = append(messages, m)
+		if n := len(posts); n == 0 || posts[n-1].MessageID != messageID {
+			posts = append(posts, CrossPost{MessageID: messageID})
+		}
+		last := &posts[len(posts)-1]
+		last.Messages = append(last.Messages, m)
 	}
 
-	c.logger.Debug("fts search", "query", query, "results", len(messages))
+	c.logger.Debug("cross-post search", "groups", len(posts))
+	return posts, rows.Err()
+}
+
+// SearchByAuthor finds cached messages whose author contains author
+// (case-insensitive), across all lists or only list when it is non-empty.
+// Fetched messages are matched through the FTS author column by token
+// prefix (substring when FTS is unavailable), and month listings by
+// substring. Results are newest first.
+func (c *Cache) SearchByAuthor(author string, list string) ([]Message, error) {
+	seen := make(map[string]bool)
+	var messages []Message
+
+	add := func(rows *sql.Rows) error {
+		defer rows.Close()
+		for rows.Next() {
+			var m Message
+			if err := rows.Scan(&m.ID, &m.List, &m.Subject, &m.Author, &m.Date); err != nil {
+				return err
+			}
+			key := m.List + "/" + m.ID
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			messages = append(messages, m)
+		}
+		return rows.Err()
+	}
+
+	// Listings carry ISO dates, so prefer them over message_content rows
+	listQuery := "SELECT id, list, subject, author, date FROM messages WHERE author LIKE ? ESCAPE '\\'"
+	listArgs := []any{"%" + escapeLike(author) + "%"}
+	if list != "" {
+		listQuery += " AND list = ?"
+		listArgs = append(listArgs, list)
+	}
+
+	rows, err := c.db.Query(listQuery, listArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("author search failed: %w", err)
+	}
+	if err := add(rows); err != nil {
+		return nil, err
+	}
+
+	if !c.fts {
+		contentQuery := "SELECT id, list, subject, author, date FROM message_content WHERE author LIKE ? ESCAPE '\\'"
+		contentArgs := []any{"%" + escapeLike(author) + "%"}
+		if list != "" {
+			contentQuery += " AND list = ?"
+			contentArgs = append(contentArgs, list)
+		}
+
+		rows, err := c.db.Query(contentQuery, contentArgs...)
+		if err != nil {
+			return nil, fmt.Errorf("author search failed: %w", err)
+		}
+		if err := add(rows); err != nil {
+			return nil, err
+		}
+	} else if match := authorMatchQuery(author); match != "" {
+		ftsQuery := `
+			SELECT mc.id, mc.list, mc.subject, mc.author, mc.date
+			FROM messages_fts fts
+			JOIN message_content mc ON fts.rowid = mc.rowid
+			WHERE messages_fts MATCH ?
+		`
+		ftsArgs := []any{match}
+		if list != "" {
+			ftsQuery += " AND mc.list = ?"
+			ftsArgs = append(ftsArgs, list)
+		}
+
+		rows, err := c.db.Query(ftsQuery, ftsArgs...)
+		if err != nil {
+			return nil, fmt.Errorf("author search failed: %w", err)
+		}
+		if err := add(rows); err != nil {
+			return nil, err
+		}
+	}
+
+	sortByDateDesc(messages)
+
+	c.logger.Debug("author search", "author", author, "list", list, "results", len(messages))
 	return messages, nil
 }
 
+// authorMatchQuery builds an FTS5 query matching every word of author as a
+// prefix within the author column, or "" when author has no words.
+func authorMatchQuery(author string) string {
+	words := strings.FieldsFunc(author, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		terms = append(terms, `author:"`+w+`"*`)
+	}
+	return strings.Join(terms, " AND ")
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// sortByDateDesc orders messages newest first. Listing dates (YYYY-MM-DD)
+// and RFC 5322 header dates are both understood; unparseable dates sort last.
+func sortByDateDesc(messages []Message) {
+	times := make([]time.Time, len(messages))
+	for i, m := range messages {
+		times[i] = parseDate(m.Date)
+	}
+	sort.Stable(byTimeDesc{messages, times})
+}
+
+type byTimeDesc struct {
+	messages []Message
+	times    []time.Time
+}
+
+func (b byTimeDesc) Len() int           { return len(b.messages) }
+func (b byTimeDesc) Less(i, j int) bool { return b.times[i].After(b.times[j]) }
+func (b byTimeDesc) Swap(i, j int) {
+	b.messages[i], b.messages[j] = b.messages[j], b.messages[i]
+	b.times[i], b.times[j] = b.times[j], b.times[i]
+}
+
+// dateFunc is a SQL function returning the Unix time of a message date as
+// parseDate reads it, or NULL when it does not parse. Header dates do not
+// sort as text, so ORDER BY goes through it.
+const dateFunc = "marc_date"
+
+func init() {
+	sqlite.MustRegisterDeterministicScalarFunction(dateFunc, 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		s, _ := args[0].(string)
+		if t := parseDate(s); !t.IsZero() {
+			return t.Unix(), nil
+		}
+		return nil, nil
+	})
+}
+
+// orderByDate returns an ORDER BY clause sorting rows by date, newest
+// first unless order is "asc", with ties broken by numeric ID so either
+// order is the exact reverse of the other. Undated rows sort as oldest.
+// prefix qualifies the date and id columns.
+func orderByDate(prefix, order string) string {
+	dir := "DESC"
+	if order == "asc" {
+		dir = "ASC"
+	}
+	return fmt.Sprintf(" ORDER BY %s(%sdate) %s, length(%sid) %s, %sid %s", dateFunc, prefix, dir, prefix, dir, prefix, dir)
+}
+
+func parseDate(s string) time.Time {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t
+	}
+	if t, err := mail.ParseDate(s); err == nil {
+		return t
+	}
+	return time.Time{}
+}
+
+// DeleteMessageContent evicts one fetched message so the next fetch parses
+// it again. The FTS triggers drop it from the search index. It reports how
+// many rows were removed.
+func (c *Cache) DeleteMessageContent(list, id string) (int64, error) {
+	if c.skipWrite("evict message_content") {
+		return 0, nil
+	}
+
+	result, err := c.db.Exec("DELETE FROM message_content WHERE list = ? AND id = ?", list, id)
+	if err != nil {
+		return 0, fmt.Errorf("delete message content: %w", err)
+	}
+
+	removed, _ := result.RowsAffected()
+	c.logger.Debug("cache evict: message_content", "list", list, "id", id, "removed", removed)
+	return removed, nil
+}
+
+// DeleteMessages evicts a month (YYYYMM) of list's month listing. It
+// reports how many rows were removed.
+func (c *Cache) DeleteMessages(list, month string) (int64, error) {
+	if c.skipWrite("evict messages") {
+		return 0, nil
+	}
+
+	if len(month) != 6 {
+		return 0, fmt.Errorf("delete messages: invalid month %q", month)
+	}
+
+	// month is YYYYMM, the same YYYY-MM date prefix GetMessages matches
+	result, err := c.db.Exec(
+		"DELETE FROM messages WHERE list = ? AND date LIKE ?",
+		list, month[:4]+"-"+month[4:]+"%",
+	)
+	if err != nil {
+		return 0, fmt.Errorf("delete messages: %w", err)
+	}
+
+	removed, _ := result.RowsAffected()
+	c.logger.Debug("cache evict: messages", "list", list, "month", month, "removed", removed)
+	return removed, nil
+}
+
 // Cleanup removes expired entries
 func (c *Cache) Cleanup() error {
-	cutoff := time.Now().Add(-c.ttl).Unix()
+	if c.skipWrite("cleanup") {
+		return nil
+	}
 
-	tables := []string{"mailing_lists", "messages", "message_content"}
+	// Use the longest configured TTL so lists with a longer override are
+	// not evicted before they expire.
+	retention := c.ttl
+	for _, ttl := range c.listTTL {
+		retention = max(retention, ttl)
+	}
+	cutoff := time.Now().Add(-retention).Unix()
+
+	tables := []string{"mailing_lists", "messages", "message_content", "message_source", "documents"}
 	for _, table := range tables {
 		result, err := c.db.Exec("DELETE FROM "+table+" WHERE updated_at < ?", cutoff)
 		if err != nil {
@@ -390,3 +1358,62 @@ func (c *Cache) Cleanup() error {
 
 	return nil
 }
+
+// AuditEntry is a single recorded tool call.
+type AuditEntry struct {
+	Time      time.Time
+	Tool      string
+	Arguments string
+	Success   bool
+	Error     string
+	Duration  time.Duration
+}
+
+// RecordAudit appends a tool call to the audit log. Audit entries are not
+// subject to the cache TTL and are never removed by Cleanup.
+func (c *Cache) RecordAudit(e AuditEntry) error {
+	if c.skipWrite("audit_log") {
+		return nil
+	}
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	_, err := c.db.Exec(
+		"INSERT INTO audit_log (created_at, tool, arguments, success, error, duration_ms) VALUES (?, ?, ?, ?, ?, ?)",
+		e.Time.Unix(), e.Tool, e.Arguments, e.Success, e.Error, e.Duration.Milliseconds(),
+	)
+
+	if err == nil {
+		c.logger.Debug("audit recorded", "tool", e.Tool, "success", e.Success)
+	}
+
+	return err
+}
+
+// AuditTail returns the most recent audit entries, newest first.
+func (c *Cache) AuditTail(limit int) ([]AuditEntry, error) {
+	rows, err := c.db.Query(
+		"SELECT created_at, tool, arguments, success, error, duration_ms FROM audit_log ORDER BY id DESC LIMIT ?",
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []AuditEntry
+	for rows.Next() {
+		var e AuditEntry
+		var createdAt, durationMS int64
+		if err := rows.Scan(&createdAt, &e.Tool, &e.Arguments, &e.Success, &e.Error, &durationMS); err != nil {
+			return nil, err
+		}
+		e.Time = time.Unix(createdAt, 0)
+		e.Duration = time.Duration(durationMS) * time.Millisecond
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
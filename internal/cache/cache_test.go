@@ -1,8 +1,14 @@
 package cache
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -85,7 +91,7 @@ func TestMailingLists(t *testing.T) {
 
 	t.Run("stores and retrieves lists", func(t *testing.T) {
 		testLists := []MailingList{
-			{Name: "git", Category: "Development"},
+			{Name: "git", Category: "Development", Description: "Git SCM discussion", PostAddress: "git@vger.kernel.org"},
 			{Name: "linux-kernel", Category: "Linux"},
 			{Name: "openssh", Category: "Security"},
 		}
@@ -105,13 +111,13 @@ func TestMailingLists(t *testing.T) {
 
 		// Results are ordered by category, name
 		expected := []MailingList{
-			{Name: "git", Category: "Development"},
+			{Name: "git", Category: "Development", Description: "Git SCM discussion", PostAddress: "git@vger.kernel.org"},
 			{Name: "linux-kernel", Category: "Linux"},
 			{Name: "openssh", Category: "Security"},
 		}
 
 		for i, l := range lists {
-			if l.Name != expected[i].Name || l.Category != expected[i].Category {
+			if l != expected[i] {
 				t.Errorf("list %d: expected %+v, got %+v", i, expected[i], l)
 			}
 		}
@@ -150,6 +156,33 @@ func TestMailingLists(t *testing.T) {
 	})
 }
 
+func TestGetListCategory(t *testing.T) {
+	c := newTestCache(t)
+
+	if _, ok := c.GetListCategory("git"); ok {
+		t.Error("expected cache miss on empty cache")
+	}
+
+	if err := c.SetMailingLists([]MailingList{{Name: "git", Category: "Development"}}); err != nil {
+		t.Fatalf("failed to set mailing lists: %v", err)
+	}
+
+	category, ok := c.GetListCategory("git")
+	if !ok || category != "Development" {
+		t.Errorf("GetListCategory(git) = %q, %v, want Development, true", category, ok)
+	}
+	if _, ok := c.GetListCategory("unknown"); ok {
+		t.Error("expected cache miss for unknown list")
+	}
+
+	if _, err := c.db.Exec("UPDATE mailing_lists SET updated_at = ?", time.Now().Add(-2*time.Hour).Unix()); err != nil {
+		t.Fatalf("failed to age mailing lists: %v", err)
+	}
+	if _, ok := c.GetListCategory("git"); ok {
+		t.Error("expected cache miss after TTL")
+	}
+}
+
 func TestMessages(t *testing.T) {
 	c := newTestCache(t)
 
@@ -239,6 +272,93 @@ func TestMessages(t *testing.T) {
 	})
 }
 
+func TestCachedMonths(t *testing.T) {
+	c := newTestCache(t)
+
+	testMessages := []Message{
+		{ID: "1", List: "git", Subject: "March", Author: "A", Date: "2026-03-02"},
+		{ID: "2", List: "git", Subject: "January", Author: "A", Date: "2026-01-10"},
+		{ID: "3", List: "git", Subject: "February", Author: "A", Date: "2026-02-14"},
+		{ID: "4", List: "git", Subject: "February again", Author: "B", Date: "2026-02-20"},
+		{ID: "5", List: "linux-kernel", Subject: "Other list", Author: "C", Date: "2025-12-01"},
+	}
+	if err := c.SetMessages(testMessages); err != nil {
+		t.Fatalf("failed to set messages: %v", err)
+	}
+
+	months, err := c.CachedMonths("git")
+	if err != nil {
+		t.Fatalf("CachedMonths failed: %v", err)
+	}
+
+	expected := []string{"202601", "202602", "202603"}
+	if len(months) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, months)
+	}
+	for i := range expected {
+		if months[i] != expected[i] {
+			t.Errorf("month %d: expected %q, got %q", i, expected[i], months[i])
+		}
+	}
+
+	empty, err := c.CachedMonths("unknown")
+	if err != nil {
+		t.Fatalf("CachedMonths failed: %v", err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("expected no months for unknown list, got %v", empty)
+	}
+}
+
+func TestFacets(t *testing.T) {
+	c := newTestCache(t)
+
+	testMessages := []Message{
+		{ID: "1", List: "git", Subject: "s", Author: "Alice", Date: "2026-01-10"},
+		{ID: "2", List: "git", Subject: "s", Author: "Alice", Date: "2026-01-12"},
+		{ID: "3", List: "git", Subject: "s", Author: "Bob", Date: "2026-02-14"},
+		{ID: "4", List: "git", Subject: "s", Author: "Alice", Date: "2026-02-20"},
+		{ID: "5", List: "git", Subject: "s", Author: "Carol", Date: "garbled"},
+		{ID: "6", List: "linux-kernel", Subject: "s", Author: "Alice", Date: "2026-01-01"},
+	}
+	// Enough distinct authors to hit the bucket limit
+	for i := range maxFacetEntries {
+		testMessages = append(testMessages, Message{ID: fmt.Sprint(100 + i), List: "git", Subject: "s", Author: fmt.Sprintf("Z%02d", i), Date: "2026-03-01"})
+	}
+	if err := c.SetMessages(testMessages); err != nil {
+		t.Fatalf("failed to set messages: %v", err)
+	}
+
+	facets, err := c.Facets("git")
+	if err != nil {
+		t.Fatalf("Facets failed: %v", err)
+	}
+
+	if len(facets.ByAuthor) != maxFacetEntries {
+		t.Errorf("expected %d authors, got %d: %v", maxFacetEntries, len(facets.ByAuthor), facets.ByAuthor)
+	}
+	if facets.ByAuthor["Alice"] != 3 || facets.ByAuthor["Bob"] != 1 || facets.ByAuthor["Carol"] != 1 {
+		t.Errorf("unexpected author counts: %v", facets.ByAuthor)
+	}
+	// Single-message authors past the limit are cut in key order
+	if _, ok := facets.ByAuthor[fmt.Sprintf("Z%02d", maxFacetEntries-1)]; ok {
+		t.Errorf("expected the last author to be cut: %v", facets.ByAuthor)
+	}
+
+	wantMonths := map[string]int{"202601": 2, "202602": 2, "202603": maxFacetEntries}
+	if !reflect.DeepEqual(facets.ByMonth, wantMonths) {
+		t.Errorf("ByMonth = %v, want %v", facets.ByMonth, wantMonths)
+	}
+
+	empty, err := c.Facets("unknown")
+	if err != nil {
+		t.Fatalf("Facets failed: %v", err)
+	}
+	if len(empty.ByAuthor) != 0 || len(empty.ByMonth) != 0 {
+		t.Errorf("expected no facets for unknown list, got %+v", empty)
+	}
+}
+
 func TestMessageContent(t *testing.T) {
 	c := newTestCache(t)
 
@@ -327,13 +447,38 @@ func TestMessageContent(t *testing.T) {
 	})
 }
 
+func TestMessageSource(t *testing.T) {
+	c := newTestCache(t)
+
+	if _, ok := c.GetMessageSource("git", "1"); ok {
+		t.Error("expected cache miss on empty cache")
+	}
+
+	source := "From: a@example.com\r\nSubject: raw\r\n\r\nbody\r\n"
+	if err := c.SetMessageSource("git", "1", source); err != nil {
+		t.Fatalf("failed to set message source: %v", err)
+	}
+
+	got, ok := c.GetMessageSource("git", "1")
+	if !ok {
+		t.Fatal("expected cache hit")
+	}
+	if got != source {
+		t.Errorf("expected source %q, got %q", source, got)
+	}
+
+	if _, ok := c.GetMessageContent("git", "1"); ok {
+		t.Error("message source must not populate message_content")
+	}
+}
+
 func TestSearchMessages(t *testing.T) {
 	c := newTestCache(t)
 
 	// Populate with test data
 	testContents := []*MessageContent{
 		{
-			Message: Message{ID: "1", List: "git", Subject: "Fix buffer overflow", Author: "Alice"},
+			Message: Message{ID: "1", List: "git", Subject: "Fix buffer overflow", Author: "Alice", Date: "Tue, 2 Jan 2024 10:00:00 +0000"},
 			Body:    "This patch fixes a critical buffer overflow in the core module.",
 		},
 		{
@@ -341,7 +486,7 @@ func TestSearchMessages(t *testing.T) {
 			Body:    "This adds a new feature for better performance.",
 		},
 		{
-			Message: Message{ID: "3", List: "linux-kernel", Subject: "Memory leak fix", Author: "Charlie"},
+			Message: Message{ID: "3", List: "linux-kernel", Subject: "Memory leak fix", Author: "Charlie", Date: "Mon, 1 Jan 2024 10:00:00 +0000"},
 			Body:    "Fixed memory leak in driver subsystem.",
 		},
 	}
@@ -376,6 +521,96 @@ func TestSearchMessages(t *testing.T) {
 		}
 	})
 
+	t.Run("excludes list", func(t *testing.T) {
+		results, err := c.SearchMessagesWithOptions(SearchOptions{Query: "fix", ExcludeList: "linux-kernel"})
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+
+		if len(results) == 0 {
+			t.Fatal("expected results from other lists")
+		}
+		for _, r := range results {
+			if r.List == "linux-kernel" {
+				t.Errorf("excluded list returned message %s", r.ID)
+			}
+		}
+	})
+
+	t.Run("include wins over exclude of the same list", func(t *testing.T) {
+		results, err := c.SearchMessagesWithOptions(SearchOptions{Query: "fix", List: "git", ExcludeList: "git"})
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+
+		if len(results) != 1 || results[0].List != "git" {
+			t.Errorf("expected the git message, got %+v", results)
+		}
+	})
+
+	t.Run("orders by date", func(t *testing.T) {
+		desc, err := c.SearchMessagesWithOptions(SearchOptions{Query: "fix", Order: "desc"})
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+		asc, err := c.SearchMessagesWithOptions(SearchOptions{Query: "fix", Order: "asc"})
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+
+		if len(desc) < 2 || len(desc) != len(asc) {
+			t.Fatalf("expected the same matches in both orders, got %d and %d", len(desc), len(asc))
+		}
+		for i := range desc {
+			if desc[i].ID != asc[len(asc)-1-i].ID {
+				t.Errorf("asc is not the reverse of desc: %+v vs %+v", desc, asc)
+				break
+			}
+		}
+		// Header dates are compared as times, not as text
+		if desc[0].ID != "1" {
+			t.Errorf("desc is not newest first: %+v", desc)
+		}
+	})
+
+	t.Run("orders all matches before the limit", func(t *testing.T) {
+		c := newTestCache(t)
+		for i := range 120 {
+			content := &MessageContent{
+				Message: Message{ID: fmt.Sprint(1000 + i), List: "git", Subject: "regression regression", Author: "A",
+					Date: fmt.Sprintf("Mon, 1 Jan 2024 10:%02d:00 +0000", i%60)},
+				Body: "regression",
+			}
+			if err := c.SetMessageContent(content); err != nil {
+				t.Fatalf("failed to set content: %v", err)
+			}
+		}
+		// The newest match is the least relevant, so it ranks past the limit
+		newest := &MessageContent{
+			Message: Message{ID: "5000", List: "git", Subject: "Release notes", Author: "B", Date: "Fri, 1 Mar 2024 10:00:00 +0000"},
+			Body:    "regression " + strings.Repeat("unrelated words ", 500),
+		}
+		if err := c.SetMessageContent(newest); err != nil {
+			t.Fatalf("failed to set content: %v", err)
+		}
+
+		desc, err := c.SearchMessagesWithOptions(SearchOptions{Query: "regression", Order: "desc"})
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+		if len(desc) != 100 || desc[0].ID != "5000" {
+			t.Errorf("expected 100 matches led by the newest, got %d starting with %+v", len(desc), desc[:1])
+		}
+
+		asc, err := c.SearchMessagesWithOptions(SearchOptions{Query: "regression", Order: "asc"})
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+		if len(asc) != 100 || asc[0].ID != "1000" || slices.ContainsFunc(asc, func(m Message) bool { return m.ID == "5000" }) {
+			t.Errorf("expected the 100 oldest matches, got %d starting with %+v", len(asc), asc[:1])
+		}
+	})
+
 	t.Run("returns empty for no matches", func(t *testing.T) {
 		results, err := c.SearchMessages("nonexistentterm123", "")
 		if err != nil {
@@ -388,6 +623,673 @@ func TestSearchMessages(t *testing.T) {
 	})
 }
 
+func TestCountSearchMessages(t *testing.T) {
+	c := newTestCache(t)
+
+	// More matches than the 100 SearchMessages returns
+	for i := range 120 {
+		if err := c.SetMessageContent(&MessageContent{
+			Message: Message{ID: fmt.Sprint(i), List: "git", Subject: "rebase fix"},
+			Headers: map[string]string{},
+		}); err != nil {
+			t.Fatalf("failed to set content: %v", err)
+		}
+	}
+	if err := c.SetMessageContent(&MessageContent{
+		Message: Message{ID: "other", List: "linux-kernel", Subject: "rebase fix"},
+		Headers: map[string]string{},
+	}); err != nil {
+		t.Fatalf("failed to set content: %v", err)
+	}
+
+	n, err := c.CountSearchMessages(SearchOptions{Query: "rebase", List: "git"})
+	if err != nil {
+		t.Fatalf("CountSearchMessages failed: %v", err)
+	}
+	if n != 120 {
+		t.Errorf("CountSearchMessages() = %d, want 120", n)
+	}
+
+	n, err = c.CountSearchMessages(SearchOptions{Query: "rebase", ExcludeList: "git"})
+	if err != nil {
+		t.Fatalf("CountSearchMessages failed: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("CountSearchMessages() excluding git = %d, want 1", n)
+	}
+}
+
+func TestWithoutFTS(t *testing.T) {
+	orig := ftsModule
+	ftsModule = "no_such_fts_module"
+	t.Cleanup(func() { ftsModule = orig })
+
+	c := newTestCache(t)
+	if c.fts {
+		t.Fatal("expected fts to be disabled")
+	}
+
+	// Writes must still work with the sync triggers gone
+	if err := c.SetMessageContent(&MessageContent{
+		Message: Message{ID: "1", List: "git", Subject: "Fix memory leak", Author: "Alice <alice@example.com>", Date: "2026-02-01"},
+		Body:    "This patch frees the buffer.",
+		Headers: map[string]string{},
+	}); err != nil {
+		t.Fatalf("failed to set content: %v", err)
+	}
+	if err := c.SetMessageContent(&MessageContent{
+		Message: Message{ID: "2", List: "openssh", Subject: "Release notes", Author: "Bob <bob@example.com>", Date: "2026-02-02"},
+		Body:    "Nothing about memory here, just buffers.",
+		Headers: map[string]string{},
+	}); err != nil {
+		t.Fatalf("failed to set content: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		list  string
+		want  int
+	}{
+		{"subject word", "leak", "", 1},
+		{"body word", "buffer", "", 2},
+		{"all words must match", "memory frees", "", 1},
+		{"list filter", "memory", "openssh", 1},
+		{"no match", "kernel", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.SearchMessages(tt.query, tt.list)
+			if err != nil {
+				t.Fatalf("SearchMessages failed: %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("SearchMessages(%q) returned %d results, want %d", tt.query, len(got), tt.want)
+			}
+
+			n, err := c.CountSearchMessages(SearchOptions{Query: tt.query, List: tt.list})
+			if err != nil {
+				t.Fatalf("CountSearchMessages failed: %v", err)
+			}
+			if n != tt.want {
+				t.Errorf("CountSearchMessages(%q) = %d, want %d", tt.query, n, tt.want)
+			}
+		})
+	}
+
+	t.Run("exclude list", func(t *testing.T) {
+		got, err := c.SearchMessagesWithOptions(SearchOptions{Query: "memory", ExcludeList: "openssh"})
+		if err != nil {
+			t.Fatalf("SearchMessagesWithOptions failed: %v", err)
+		}
+		if len(got) != 1 || got[0].List != "git" {
+			t.Errorf("expected only the git message, got %+v", got)
+		}
+	})
+
+	t.Run("author search", func(t *testing.T) {
+		got, err := c.SearchByAuthor("ALICE@", "")
+		if err != nil {
+			t.Fatalf("SearchByAuthor failed: %v", err)
+		}
+		if len(got) != 1 || got[0].ID != "1" {
+			t.Errorf("unexpected results: %+v", got)
+		}
+	})
+}
+
+func TestSearchByAuthor(t *testing.T) {
+	c := newTestCache(t)
+
+	if err := c.SetMessages([]Message{
+		{ID: "1", List: "git", Subject: "Old patch", Author: "Junio C Hamano", Date: "2026-01-10"},
+		{ID: "2", List: "linux-kernel", Subject: "New patch", Author: "Junio C Hamano", Date: "2026-02-20"},
+		{ID: "3", List: "git", Subject: "Unrelated", Author: "Jeff King", Date: "2026-02-25"},
+	}); err != nil {
+		t.Fatalf("failed to set messages: %v", err)
+	}
+	if err := c.SetMessageContent(&MessageContent{
+		Message: Message{ID: "4", List: "openssh", Subject: "Fetched", Author: "Junio Hamano <gitster@example.com>", Date: "Sun, 1 Mar 2026 09:00:00 +0000"},
+		Body:    "body",
+		Headers: map[string]string{},
+	}); err != nil {
+		t.Fatalf("failed to set content: %v", err)
+	}
+
+	ids := func(messages []Message) []string {
+		out := make([]string, len(messages))
+		for i, m := range messages {
+			out[i] = m.ID
+		}
+		return out
+	}
+
+	tests := []struct {
+		name   string
+		author string
+		list   string
+		want   []string
+	}{
+		{"partial lowercase across lists", "hamano", "", []string{"4", "2", "1"}},
+		{"substring within a word", "unio c", "", []string{"2", "1"}},
+		{"prefix of fetched author", "GITSTER", "", []string{"4"}},
+		{"list filter", "hamano", "git", []string{"1"}},
+		{"no match", "torvalds", "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.SearchByAuthor(tt.author, tt.list)
+			if err != nil {
+				t.Fatalf("SearchByAuthor failed: %v", err)
+			}
+			if strings.Join(ids(got), ",") != strings.Join(tt.want, ",") {
+				t.Errorf("SearchByAuthor(%q, %q) = %v, want %v", tt.author, tt.list, ids(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestFindCrossPosts(t *testing.T) {
+	c := newTestCache(t)
+
+	contents := []*MessageContent{
+		{Message: Message{ID: "1", List: "git", Subject: "[PATCH] shared"}, Headers: map[string]string{"Message-ID": "<x@example.com>"}},
+		{Message: Message{ID: "2", List: "linux-kernel", Subject: "[PATCH] shared"}, Headers: map[string]string{"Message-Id": "<x@example.com>"}},
+		{Message: Message{ID: "3", List: "git", Subject: "single"}, Headers: map[string]string{"Message-ID": "<y@example.com>"}},
+		{Message: Message{ID: "4", List: "git", Subject: "no id"}, Headers: map[string]string{}},
+		{Message: Message{ID: "5", List: "openssh", Subject: "no id either"}, Headers: map[string]string{}},
+	}
+	for _, m := range contents {
+		if err := c.SetMessageContent(m); err != nil {
+			t.Fatalf("failed to set content: %v", err)
+		}
+	}
+
+	posts, err := c.FindCrossPosts()
+	if err != nil {
+		t.Fatalf("FindCrossPosts failed: %v", err)
+	}
+	if len(posts) != 1 {
+		t.Fatalf("expected 1 cross-post, got %+v", posts)
+	}
+	if posts[0].MessageID != "<x@example.com>" {
+		t.Errorf("MessageID = %q", posts[0].MessageID)
+	}
+	if len(posts[0].Messages) != 2 || posts[0].Messages[0].List != "git" || posts[0].Messages[1].List != "linux-kernel" {
+		t.Errorf("unexpected messages: %+v", posts[0].Messages)
+	}
+}
+
+func TestMigrateMessageIDColumn(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "old.db")
+
+	// Schema and row as written before message_id existed
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE message_content (id TEXT PRIMARY KEY, list TEXT NOT NULL, subject TEXT NOT NULL, author TEXT NOT NULL, date TEXT NOT NULL, body TEXT NOT NULL, headers TEXT NOT NULL, updated_at INTEGER NOT NULL)`,
+		`INSERT INTO message_content VALUES ('1', 'git', 's', 'a', 'd', 'b', '{"Message-ID":"<x@example.com>"}', 0)`,
+		`INSERT INTO message_content VALUES ('2', 'linux-kernel', 's', 'a', 'd', 'b', '{"message-id":"<x@example.com>"}', 0)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to prepare old schema: %v", err)
+		}
+	}
+	db.Close()
+
+	c, err := New(Options{DBPath: dbPath, TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("failed to open old cache: %v", err)
+	}
+	defer c.Close()
+
+	posts, err := c.FindCrossPosts()
+	if err != nil {
+		t.Fatalf("FindCrossPosts failed: %v", err)
+	}
+	if len(posts) != 1 || len(posts[0].Messages) != 2 {
+		t.Errorf("expected backfilled cross-post, got %+v", posts)
+	}
+}
+
+func TestMigrateMailingListColumns(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "old.db")
+
+	// Schema and row as written before list descriptions existed
+	db, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE mailing_lists (name TEXT PRIMARY KEY, category TEXT NOT NULL, updated_at INTEGER NOT NULL)`,
+		`INSERT INTO mailing_lists VALUES ('git', 'Development', 0)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("failed to prepare old schema: %v", err)
+		}
+	}
+	db.Close()
+
+	c, err := New(Options{DBPath: dbPath, TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("failed to open old cache: %v", err)
+	}
+	defer c.Close()
+
+	lists, ok := c.GetStaleMailingLists()
+	if !ok || len(lists) != 1 || lists[0] != (MailingList{Name: "git", Category: "Development"}) {
+		t.Errorf("expected the old row with empty metadata, got %+v", lists)
+	}
+
+	if err := c.SetMailingLists([]MailingList{{Name: "git", Category: "Development", Description: "Git"}}); err != nil {
+		t.Fatalf("failed to set mailing lists: %v", err)
+	}
+	lists, ok = c.GetMailingLists()
+	if !ok || len(lists) != 1 || lists[0].Description != "Git" {
+		t.Errorf("expected the description to be stored, got %+v", lists)
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "shared.db")
+
+	rw, err := New(Options{DBPath: dbPath, TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("failed to create cache: %v", err)
+	}
+	if err := rw.SetMessages([]Message{{ID: "1", List: "git", Subject: "seeded", Author: "A", Date: "2026-01-01"}}); err != nil {
+		t.Fatalf("failed to seed messages: %v", err)
+	}
+	if err := rw.SetMessageContent(&MessageContent{Message: Message{ID: "1", List: "git", Subject: "seeded"}, Body: "seeded body"}); err != nil {
+		t.Fatalf("failed to seed content: %v", err)
+	}
+	rw.Close()
+
+	c, err := New(Options{DBPath: dbPath, TTL: time.Hour, ReadOnly: true, Pragmas: map[string]string{"cache_size": "-1000"}})
+	if err != nil {
+		t.Fatalf("failed to open read-only cache: %v", err)
+	}
+	defer c.Close()
+
+	// Writes are accepted and ignored
+	if err := c.SetMessages([]Message{{ID: "2", List: "git", Subject: "new", Author: "B", Date: "2026-01-02"}}); err != nil {
+		t.Errorf("SetMessages failed: %v", err)
+	}
+	if err := c.SetMessageContent(&MessageContent{Message: Message{ID: "1", List: "git", Subject: "changed"}, Body: "changed"}); err != nil {
+		t.Errorf("SetMessageContent failed: %v", err)
+	}
+	if removed, err := c.DeleteMessages("git", "202601"); err != nil || removed != 0 {
+		t.Errorf("DeleteMessages = %d, %v; want 0, nil", removed, err)
+	}
+	if err := c.Cleanup(); err != nil {
+		t.Errorf("Cleanup failed: %v", err)
+	}
+	if err := c.RecordAudit(AuditEntry{Tool: "list_messages"}); err != nil {
+		t.Errorf("RecordAudit failed: %v", err)
+	}
+
+	// Reads see the seeded data only
+	messages, ok := c.GetMessages("git", "202601")
+	if !ok || len(messages) != 1 || messages[0].ID != "1" {
+		t.Errorf("expected the seeded listing, got %+v", messages)
+	}
+	content, ok := c.GetMessageContent("git", "1")
+	if !ok || content.Body != "seeded body" {
+		t.Errorf("expected the seeded content, got %+v", content)
+	}
+	if results, err := c.SearchMessages("seeded", ""); err != nil || len(results) != 1 {
+		t.Errorf("SearchMessages = %+v, %v; want the seeded message", results, err)
+	}
+
+	// The connection itself refuses writes
+	if _, err := c.db.Exec("DELETE FROM messages"); err == nil {
+		t.Error("expected a write on the read-only connection to fail")
+	}
+
+	if _, err := New(Options{DBPath: filepath.Join(t.TempDir(), "missing.db"), ReadOnly: true}); err == nil {
+		t.Error("expected an error opening a missing read-only database")
+	}
+}
+
+func TestPragmas(t *testing.T) {
+	c, err := New(Options{
+		DBPath:  filepath.Join(t.TempDir(), "pragmas.db"),
+		TTL:     time.Hour,
+		Pragmas: map[string]string{"cache_size": "-1234", "temp_store": "memory"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create cache: %v", err)
+	}
+	defer c.Close()
+
+	// Every pooled connection must carry the settings, so read them back
+	// on several connections held open at once
+	for range 3 {
+		conn, err := c.db.Conn(context.Background())
+		if err != nil {
+			t.Fatalf("failed to get connection: %v", err)
+		}
+		defer conn.Close()
+
+		var cacheSize, tempStore int
+		if err := conn.QueryRowContext(context.Background(), "PRAGMA cache_size").Scan(&cacheSize); err != nil {
+			t.Fatalf("failed to read cache_size: %v", err)
+		}
+		if err := conn.QueryRowContext(context.Background(), "PRAGMA temp_store").Scan(&tempStore); err != nil {
+			t.Fatalf("failed to read temp_store: %v", err)
+		}
+		if cacheSize != -1234 || tempStore != 2 {
+			t.Errorf("cache_size = %d, temp_store = %d; want -1234, 2", cacheSize, tempStore)
+		}
+	}
+
+	// The schema is still created on a tuned connection
+	if err := c.SetDocument("git", "k", "v"); err != nil {
+		t.Errorf("cache unusable with pragmas: %v", err)
+	}
+
+	for name, pragmas := range map[string]map[string]string{
+		"not allowed":   {"journal_mode": "off"},
+		"durability":    {"synchronous": "off"},
+		"invalid value": {"cache_size": "1); DROP TABLE messages; --"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(Options{DBPath: filepath.Join(t.TempDir(), "bad.db"), Pragmas: pragmas})
+			if err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestDataSourceNameEscapesPath(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "odd?name #1 100%")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	dbPath := filepath.Join(dir, "cache.db")
+
+	for _, opts := range []Options{
+		{DBPath: dbPath, TTL: time.Hour},
+		{DBPath: dbPath, TTL: time.Hour, Pragmas: map[string]string{"cache_size": "-1000"}},
+		{DBPath: dbPath, TTL: time.Hour, ReadOnly: true},
+	} {
+		c, err := New(opts)
+		if err != nil {
+			t.Fatalf("New(%+v) failed: %v", opts, err)
+		}
+		if err := c.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	}
+
+	// Only the named file was created, not one cut off at a special character
+	entries, err := os.ReadDir(filepath.Dir(dir))
+	if err != nil {
+		t.Fatalf("failed to read directory: %v", err)
+	}
+	if len(entries) != 1 {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("expected only the database directory, got %q", names)
+	}
+	if _, err := os.Stat(dbPath); err != nil {
+		t.Errorf("database not at its path: %v", err)
+	}
+}
+
+func TestEviction(t *testing.T) {
+	c := newTestCache(t)
+
+	for _, m := range []*MessageContent{
+		{Message: Message{ID: "1", List: "git", Subject: "broken parse"}, Body: "evictme", Headers: map[string]string{}},
+		{Message: Message{ID: "2", List: "git", Subject: "keep"}, Body: "evictme too", Headers: map[string]string{}},
+		{Message: Message{ID: "3", List: "openssh", Subject: "other list"}, Body: "other", Headers: map[string]string{}},
+	} {
+		if err := c.SetMessageContent(m); err != nil {
+			t.Fatalf("failed to set content: %v", err)
+		}
+	}
+	if err := c.SetMessages([]Message{
+		{ID: "10", List: "git", Subject: "a", Author: "a", Date: "2026-02-01"},
+		{ID: "11", List: "git", Subject: "b", Author: "b", Date: "2026-02-28"},
+		{ID: "12", List: "git", Subject: "c", Author: "c", Date: "2026-03-01"},
+		{ID: "13", List: "openssh", Subject: "d", Author: "d", Date: "2026-02-10"},
+	}); err != nil {
+		t.Fatalf("failed to set messages: %v", err)
+	}
+
+	t.Run("message content", func(t *testing.T) {
+		removed, err := c.DeleteMessageContent("git", "1")
+		if err != nil {
+			t.Fatalf("DeleteMessageContent failed: %v", err)
+		}
+		if removed != 1 {
+			t.Errorf("removed = %d, want 1", removed)
+		}
+
+		if _, ok := c.GetMessageContent("git", "1"); ok {
+			t.Error("expected evicted message to be gone")
+		}
+		if _, ok := c.GetMessageContent("git", "2"); !ok {
+			t.Error("expected unrelated message to survive")
+		}
+		if _, ok := c.GetMessageContent("openssh", "3"); !ok {
+			t.Error("expected message on another list to survive")
+		}
+
+		results, err := c.SearchMessages("evictme", "")
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+		if len(results) != 1 || results[0].ID != "2" {
+			t.Errorf("expected FTS entry of the evicted message to be gone, got %+v", results)
+		}
+
+		if removed, _ := c.DeleteMessageContent("git", "1"); removed != 0 {
+			t.Errorf("second eviction removed %d rows, want 0", removed)
+		}
+	})
+
+	t.Run("month listing", func(t *testing.T) {
+		removed, err := c.DeleteMessages("git", "202602")
+		if err != nil {
+			t.Fatalf("DeleteMessages failed: %v", err)
+		}
+		if removed != 2 {
+			t.Errorf("removed = %d, want 2", removed)
+		}
+
+		if _, ok := c.GetMessages("git", "202602"); ok {
+			t.Error("expected evicted month to be gone")
+		}
+		if _, ok := c.GetMessages("git", "202603"); !ok {
+			t.Error("expected other month to survive")
+		}
+		if _, ok := c.GetMessages("openssh", "202602"); !ok {
+			t.Error("expected other list to survive")
+		}
+
+		if _, err := c.DeleteMessages("git", "2026-02"); err == nil {
+			t.Error("expected error for malformed month")
+		}
+	})
+}
+
+func TestListTTLOverrides(t *testing.T) {
+	c, err := New(Options{
+		DBPath: filepath.Join(t.TempDir(), "list-ttl.db"),
+		TTL:    time.Hour,
+		ListTTL: map[string]time.Duration{
+			"linux-kernel": 10 * time.Minute,
+			"git":          2 * time.Hour,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create cache: %v", err)
+	}
+	defer c.Close()
+
+	// age backdates every row of a table, avoiding sleeps in the test.
+	age := func(table string, d time.Duration) {
+		t.Helper()
+		if _, err := c.db.Exec("UPDATE "+table+" SET updated_at = ?", time.Now().Add(-d).Unix()); err != nil {
+			t.Fatalf("failed to age %s: %v", table, err)
+		}
+	}
+
+	testMessages := []Message{
+		{ID: "1", List: "linux-kernel", Subject: "Hot", Author: "A", Date: "2026-02-15"},
+		{ID: "2", List: "git", Subject: "Dormant", Author: "B", Date: "2026-02-15"},
+		{ID: "3", List: "openssh", Subject: "Default", Author: "C", Date: "2026-02-15"},
+	}
+	if err := c.SetMessages(testMessages); err != nil {
+		t.Fatalf("failed to set messages: %v", err)
+	}
+
+	t.Run("shorter override expires sooner", func(t *testing.T) {
+		age("messages", 30*time.Minute)
+
+		if _, ok := c.GetMessages("linux-kernel", "202602"); ok {
+			t.Error("expected cache miss for linux-kernel past its 10m override")
+		}
+		if _, ok := c.GetMessages("openssh", "202602"); !ok {
+			t.Error("expected cache hit for openssh within global TTL")
+		}
+	})
+
+	t.Run("longer override stays fresh", func(t *testing.T) {
+		age("messages", 90*time.Minute)
+
+		if _, ok := c.GetMessages("git", "202602"); !ok {
+			t.Error("expected cache hit for git within its 2h override")
+		}
+		if _, ok := c.GetMessages("openssh", "202602"); ok {
+			t.Error("expected cache miss for openssh past global TTL")
+		}
+	})
+
+	t.Run("mailing lists honor overrides", func(t *testing.T) {
+		lists := []MailingList{
+			{Name: "linux-kernel", Category: "Linux"},
+			{Name: "git", Category: "Development"},
+		}
+		if err := c.SetMailingLists(lists); err != nil {
+			t.Fatalf("failed to set lists: %v", err)
+		}
+
+		age("mailing_lists", 5*time.Minute)
+		if _, ok := c.GetMailingLists(); !ok {
+			t.Error("expected cache hit while every list is fresh")
+		}
+
+		age("mailing_lists", 30*time.Minute)
+		if _, ok := c.GetMailingLists(); ok {
+			t.Error("expected cache miss once linux-kernel outlives its override")
+		}
+	})
+
+	t.Run("mailing lists past the global TTL miss despite a longer override", func(t *testing.T) {
+		lists := []MailingList{
+			{Name: "openssh", Category: "Security"},
+			{Name: "git", Category: "Development"},
+		}
+		if err := c.SetMailingLists(lists); err != nil {
+			t.Fatalf("failed to set lists: %v", err)
+		}
+
+		// git is within its 2h override, openssh is past the 1h global TTL
+		age("mailing_lists", 90*time.Minute)
+		if got, ok := c.GetMailingLists(); ok {
+			t.Errorf("expected cache miss, got a hit with %+v", got)
+		}
+	})
+
+	t.Run("setting mailing lists replaces the catalog", func(t *testing.T) {
+		if err := c.SetMailingLists([]MailingList{{Name: "git", Category: "Development"}}); err != nil {
+			t.Fatalf("failed to set lists: %v", err)
+		}
+		got, ok := c.GetMailingLists()
+		if !ok || len(got) != 1 || got[0].Name != "git" {
+			t.Errorf("GetMailingLists() = %+v, %v; want only git", got, ok)
+		}
+	})
+}
+
+func TestAuditLog(t *testing.T) {
+	c := newTestCache(t)
+
+	entries := []AuditEntry{
+		{Tool: "list_messages", Arguments: `{"list":"git"}`, Success: true, Duration: 150 * time.Millisecond},
+		{Tool: "get_message", Arguments: `{"list":"git","message_id":"1"}`, Error: "failed to get message: boom", Duration: 2 * time.Second},
+		{Tool: "help", Arguments: `{}`, Success: true},
+	}
+	for _, e := range entries {
+		if err := c.RecordAudit(e); err != nil {
+			t.Fatalf("failed to record audit entry: %v", err)
+		}
+	}
+
+	t.Run("returns newest first", func(t *testing.T) {
+		tail, err := c.AuditTail(10)
+		if err != nil {
+			t.Fatalf("failed to read audit log: %v", err)
+		}
+		if len(tail) != 3 {
+			t.Fatalf("expected 3 entries, got %d", len(tail))
+		}
+		if tail[0].Tool != "help" || tail[2].Tool != "list_messages" {
+			t.Errorf("unexpected order: %s, %s, %s", tail[0].Tool, tail[1].Tool, tail[2].Tool)
+		}
+
+		got := tail[1]
+		if got.Success || got.Error != "failed to get message: boom" {
+			t.Errorf("unexpected outcome: %+v", got)
+		}
+		if got.Arguments != `{"list":"git","message_id":"1"}` {
+			t.Errorf("unexpected arguments: %s", got.Arguments)
+		}
+		if got.Duration != 2*time.Second {
+			t.Errorf("duration = %v, want 2s", got.Duration)
+		}
+		if got.Time.IsZero() {
+			t.Error("expected timestamp to be set")
+		}
+	})
+
+	t.Run("respects limit", func(t *testing.T) {
+		tail, err := c.AuditTail(1)
+		if err != nil {
+			t.Fatalf("failed to read audit log: %v", err)
+		}
+		if len(tail) != 1 || tail[0].Tool != "help" {
+			t.Errorf("unexpected tail: %+v", tail)
+		}
+	})
+
+	t.Run("survives cleanup", func(t *testing.T) {
+		if _, err := c.db.Exec("UPDATE audit_log SET created_at = ?", time.Now().Add(-48*time.Hour).Unix()); err != nil {
+			t.Fatalf("failed to age audit log: %v", err)
+		}
+		if err := c.Cleanup(); err != nil {
+			t.Fatalf("cleanup failed: %v", err)
+		}
+		tail, err := c.AuditTail(10)
+		if err != nil {
+			t.Fatalf("failed to read audit log: %v", err)
+		}
+		if len(tail) != 3 {
+			t.Errorf("expected audit entries to survive cleanup, got %d", len(tail))
+		}
+	})
+}
+
 func TestCleanup(t *testing.T) {
 	tmpDir := t.TempDir()
 	dbPath := filepath.Join(tmpDir, "cleanup.db")
@@ -0,0 +1,186 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// exportFormat and exportVersion identify a dump written by Export. Bump
+// the version when a change to exportTables cannot be read by older builds.
+const (
+	exportFormat  = "marc-mcp-cache"
+	exportVersion = 1
+)
+
+// ErrExportVersion is returned by Import for a stream that is not a cache
+// dump or was written by a newer, incompatible version.
+var ErrExportVersion = errors.New("unsupported cache export")
+
+// ErrReadOnly is returned by Import on a read-only cache.
+var ErrReadOnly = errors.New("cache is read-only")
+
+// exportTables are the tables carried by a dump and their columns. The
+// audit log is local to each server and is left out; messages_fts is
+// rebuilt from message_content on import.
+var exportTables = []struct {
+	name    string
+	columns []string
+}{
+	{"mailing_lists", []string{"name", "category", "description", "post_address", "updated_at"}},
+	{"messages", []string{"id", "list", "subject", "author", "date", "updated_at"}},
+	{"message_content", []string{"id", "list", "subject", "author", "date", "body", "headers", "message_id", "fingerprint", "updated_at"}},
+	{"message_source", []string{"id", "list", "source", "updated_at"}},
+	{"documents", []string{"key", "list", "content", "updated_at"}},
+	{"summaries", []string{"id", "message_id", "summary_type", "content", "model", "created_at"}},
+}
+
+// dump is the JSON document written by Export. Rows keep their column
+// order and updated_at, so imported entries expire as they would have on
+// the exporting machine.
+type dump struct {
+	Format     string               `json:"format"`
+	Version    int                  `json:"version"`
+	ExportedAt time.Time            `json:"exported_at"`
+	Tables     map[string]dumpTable `json:"tables"`
+}
+
+type dumpTable struct {
+	Columns []string `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+}
+
+// Export writes the cached mailing lists, listings, message contents and
+// sources, documents and summaries to w as a versioned JSON dump that
+// Import reads back.
+func (c *Cache) Export(w io.Writer) error {
+	d := dump{
+		Format:     exportFormat,
+		Version:    exportVersion,
+		ExportedAt: time.Now().UTC(),
+		Tables:     make(map[string]dumpTable, len(exportTables)),
+	}
+
+	for _, t := range exportTables {
+		rows, err := c.exportTable(t.name, t.columns)
+		if err != nil {
+			return fmt.Errorf("export %s: %w", t.name, err)
+		}
+		d.Tables[t.name] = dumpTable{Columns: t.columns, Rows: rows}
+		c.logger.Debug("cache export", "table", t.name, "rows", len(rows))
+	}
+
+	return json.NewEncoder(w).Encode(d)
+}
+
+func (c *Cache) exportTable(table string, columns []string) ([][]any, error) {
+	rows, err := c.db.Query("SELECT " + strings.Join(columns, ", ") + " FROM " + table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([][]any, 0)
+	for rows.Next() {
+		values := make([]any, len(columns))
+		ptrs := make([]any, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			if b, ok := v.([]byte); ok {
+				values[i] = string(b)
+			}
+		}
+		out = append(out, values)
+	}
+	return out, rows.Err()
+}
+
+// Import restores a dump written by Export in a single transaction.
+// Imported rows replace cached rows with the same key; other cached data
+// is kept. The full-text index is rebuilt afterwards. A dump from a newer
+// version fails with ErrExportVersion without changing anything.
+func (c *Cache) Import(r io.Reader) error {
+	if c.readOnly {
+		return ErrReadOnly
+	}
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var d dump
+	if err := dec.Decode(&d); err != nil {
+		return fmt.Errorf("%w: %v", ErrExportVersion, err)
+	}
+	if d.Format != exportFormat {
+		return fmt.Errorf("%w: format %q, want %q", ErrExportVersion, d.Format, exportFormat)
+	}
+	if d.Version < 1 || d.Version > exportVersion {
+		return fmt.Errorf("%w: version %d, this build reads up to %d", ErrExportVersion, d.Version, exportVersion)
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, t := range exportTables {
+		table, ok := d.Tables[t.name]
+		if !ok {
+			continue
+		}
+		// Column names go into the statement, so only known ones are allowed
+		for _, col := range table.Columns {
+			if !slices.Contains(t.columns, col) {
+				return fmt.Errorf("%w: unknown column %s.%s", ErrExportVersion, t.name, col)
+			}
+		}
+
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(table.Columns)), ", ")
+		stmt, err := tx.Prepare(fmt.Sprintf(
+			"INSERT OR REPLACE INTO %s (%s) VALUES (%s)",
+			t.name, strings.Join(table.Columns, ", "), placeholders,
+		))
+		if err != nil {
+			return fmt.Errorf("import %s: %w", t.name, err)
+		}
+		defer stmt.Close()
+
+		for i, row := range table.Rows {
+			if len(row) != len(table.Columns) {
+				return fmt.Errorf("%w: %s row %d has %d values for %d columns", ErrExportVersion, t.name, i, len(row), len(table.Columns))
+			}
+			for j, v := range row {
+				if n, ok := v.(json.Number); ok {
+					row[j], err = n.Int64()
+					if err != nil {
+						return fmt.Errorf("%w: %s row %d: %v", ErrExportVersion, t.name, i, err)
+					}
+				}
+			}
+			if _, err := stmt.Exec(row...); err != nil {
+				return fmt.Errorf("import %s: %w", t.name, err)
+			}
+		}
+		c.logger.Debug("cache import", "table", t.name, "rows", len(table.Rows))
+	}
+
+	// REPLACE deletes conflicting rows without firing the delete trigger,
+	// so the index may hold stale entries until it is rebuilt
+	if c.fts {
+		if _, err := tx.Exec("INSERT INTO messages_fts(messages_fts) VALUES ('rebuild')"); err != nil {
+			return fmt.Errorf("rebuild messages_fts: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
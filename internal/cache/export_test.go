@@ -0,0 +1,85 @@
+package cache
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExportImport(t *testing.T) {
+	src := newTestCache(t)
+	if err := src.SetMailingLists([]MailingList{{Name: "git", Category: "Development", Description: "Git SCM"}}); err != nil {
+		t.Fatalf("SetMailingLists failed: %v", err)
+	}
+	if err := src.SetMessages([]Message{{ID: "1", List: "git", Subject: "rebase woes", Author: "Alice", Date: "2026-02-01"}}); err != nil {
+		t.Fatalf("SetMessages failed: %v", err)
+	}
+	if err := src.SetMessageContent(&MessageContent{
+		Message:     Message{ID: "1", List: "git", Subject: "rebase woes", Author: "Alice", Date: "2026-02-01"},
+		Body:        "interactive rebase drops my fixups",
+		Headers:     map[string]string{"Message-ID": "<woes@example.com>"},
+		Fingerprint: "abc",
+	}); err != nil {
+		t.Fatalf("SetMessageContent failed: %v", err)
+	}
+	if err := src.SetMessageSource("git", "1", "From: Alice\n\nraw"); err != nil {
+		t.Fatalf("SetMessageSource failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := src.Export(&buf); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	dst := newTestCache(t)
+	// A stale copy of the message must be replaced, and stay searchable
+	// only by its imported text
+	if err := dst.SetMessageContent(&MessageContent{Message: Message{ID: "1", List: "git", Subject: "old"}, Body: "obsolete text"}); err != nil {
+		t.Fatalf("SetMessageContent failed: %v", err)
+	}
+	if err := dst.Import(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	if lists, ok := dst.GetMailingLists(); !ok || len(lists) != 1 || lists[0].Description != "Git SCM" {
+		t.Errorf("imported mailing lists = %+v", lists)
+	}
+	if messages, ok := dst.GetMessages("git", "202602"); !ok || len(messages) != 1 {
+		t.Errorf("imported listing = %+v", messages)
+	}
+	content, ok := dst.GetMessageContent("git", "1")
+	if !ok || content.Body != "interactive rebase drops my fixups" || content.Fingerprint != "abc" || content.Headers["Message-ID"] != "<woes@example.com>" {
+		t.Errorf("imported content = %+v", content)
+	}
+	if source, ok := dst.GetMessageSource("git", "1"); !ok || source != "From: Alice\n\nraw" {
+		t.Errorf("imported source = %q", source)
+	}
+
+	results, err := dst.SearchMessages("fixups", "")
+	if err != nil || len(results) != 1 || results[0].ID != "1" {
+		t.Errorf("SearchMessages(fixups) = %+v, %v; want message 1", results, err)
+	}
+	if results, err := dst.SearchMessages("obsolete", ""); err != nil || len(results) != 0 {
+		t.Errorf("SearchMessages(obsolete) = %+v, %v; want no results", results, err)
+	}
+}
+
+func TestImportRejectsUnsupportedDumps(t *testing.T) {
+	c := newTestCache(t)
+
+	tests := map[string]string{
+		"not json":      "garbage",
+		"other format":  `{"format":"something-else","version":1}`,
+		"newer version": `{"format":"marc-mcp-cache","version":99,"tables":{}}`,
+		"bad column":    `{"format":"marc-mcp-cache","version":1,"tables":{"messages":{"columns":["id; DROP TABLE messages"],"rows":[]}}}`,
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := c.Import(strings.NewReader(input))
+			if !errors.Is(err, ErrExportVersion) {
+				t.Errorf("Import() error = %v, want ErrExportVersion", err)
+			}
+		})
+	}
+}
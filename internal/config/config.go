@@ -24,6 +24,11 @@ type Config struct {
 	IdleTimeout     time.Duration
 	ShutdownTimeout time.Duration
 	MaxHeaderBytes  int
+	Audit           bool
+	// AdminTools registers the tools that change server state for every
+	// caller, such as admin_reconfigure, cache_evict and cache_import
+	// (MARC_ADMIN_TOOLS).
+	AdminTools bool
 }
 
 func Load() (Config, error) {
@@ -37,6 +42,8 @@ func Load() (Config, error) {
 		IdleTimeout:     getDurationEnv("IDLE_TIMEOUT", 60*time.Second),
 		ShutdownTimeout: getDurationEnv("SHUTDOWN_TIMEOUT", 10*time.Second),
 		MaxHeaderBytes:  getIntEnv("MAX_HEADER_BYTES", 1<<20),
+		Audit:           getBoolEnv("MARC_AUDIT", false),
+		AdminTools:      getBoolEnv("MARC_ADMIN_TOOLS", false),
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -97,3 +104,16 @@ func getIntEnv(key string, fallback int) int {
 	}
 	return n
 }
+
+func getBoolEnv(key string, fallback bool) bool {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return fallback
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fallback
+	}
+	return b
+}
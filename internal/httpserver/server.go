@@ -24,10 +24,26 @@ func New(cfg config.Config, logger *slog.Logger, version string) (*Server, error
 	if err != nil {
 		return nil, err
 	}
+	if cfg.AdminTools {
+		if err := tools.RegisterAdminTools(registry); err != nil {
+			return nil, err
+		}
+	}
+
+	mcpOpts := []transport.Option{
+		transport.WithToolMiddleware(transport.CorrelationMiddleware(logger)),
+	}
+	if cfg.Audit {
+		client, err := tools.SharedClient()
+		if err != nil {
+			return nil, err
+		}
+		mcpOpts = append(mcpOpts, transport.WithToolMiddleware(transport.AuditMiddleware(client, logger)))
+	}
 
 	mux.HandleFunc("/health", healthHandler)
 	mux.Handle("/mcp", chain(
-		transport.NewMCPHandler(registry, version),
+		transport.NewMCPHandler(registry, version, mcpOpts...),
 		middleware.RequestID,
 		middleware.Logging(logger),
 		buildAuthMiddleware(cfg),
@@ -0,0 +1,42 @@
+// Package logctx carries a request-scoped logger in a context, so every log
+// line written on behalf of one tool call can be attributed to it.
+package logctx
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+)
+
+// CorrelationKey is the log attribute holding the correlation ID.
+const CorrelationKey = "correlation_id"
+
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying logger.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger stored in ctx, or nil if there is none.
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx == nil {
+		return nil
+	}
+	logger, _ := ctx.Value(loggerKey{}).(*slog.Logger)
+	return logger
+}
+
+// WithCorrelationID stores logger, tagged with id, in ctx.
+func WithCorrelationID(ctx context.Context, logger *slog.Logger, id string) context.Context {
+	return WithLogger(ctx, logger.With(CorrelationKey, id))
+}
+
+// NewCorrelationID returns a short random ID; 8 hex digits are plenty to
+// tell concurrent calls apart in the logs.
+func NewCorrelationID() string {
+	var b [4]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,73 @@
+package marc
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// activityWorkers bounds how many lists ListsActiveSince checks at once.
+const activityWorkers = 4
+
+// ListsActiveSince returns the lists, sorted by name, whose current month
+// has a message dated on or after since. Every list with cached month
+// listings is checked, plus any lists named in extra. The first page of
+// each current month is fetched live, like WaitForNewMessages polls, and
+// stored in the cache. Listing dates are days, so a
+// list whose newest message falls on the same day as since is reported.
+// Lists that cannot be listed are logged and skipped.
+func (c *Client) ListsActiveSince(since time.Time, extra ...string) ([]string, error) {
+	lists, err := c.cache.CachedLists()
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range extra {
+		if l != "" && !slices.Contains(lists, l) {
+			lists = append(lists, l)
+		}
+	}
+
+	c.logger.Debug("checking list activity", "since", since, "lists", len(lists))
+
+	// Compare whole days in UTC, matching the listing dates
+	sinceDay := since.UTC().Format("2006-01-02")
+
+	var mu sync.Mutex
+	active := make([]string, 0)
+	forEachLimited(activityWorkers, len(lists), func(i int) {
+		newest, err := c.newestMessageDate(lists[i])
+		if err != nil {
+			c.logger.Warn("skipping list activity check", "list", lists[i], "error", err)
+			return
+		}
+		if newest != "" && newest >= sinceDay {
+			mu.Lock()
+			active = append(active, lists[i])
+			mu.Unlock()
+		}
+	})
+
+	sort.Strings(active)
+	return active, nil
+}
+
+// newestMessageDate returns the latest YYYY-MM-DD date in the first page of
+// list's current month, or "" when the month has no messages yet. The page
+// is always fetched, since a fresh cached listing can predate new posts.
+func (c *Client) newestMessageDate(list string) (string, error) {
+	messages, _, err := c.fetchMessagePage(c.normalizeList(list), time.Now().Format("200601"), 1)
+	if err != nil {
+		return "", err
+	}
+	c.storeMessages(messages)
+
+	var newest string
+	for _, m := range messages {
+		if d := strings.TrimSpace(m.Date); len(d) >= 10 && d[:10] > newest {
+			newest = d[:10]
+		}
+	}
+	return newest, nil
+}
@@ -0,0 +1,109 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+func TestListsActiveSince(t *testing.T) {
+	today := time.Now().UTC().Format("2006-01-02")
+
+	pages := map[string]string{
+		"git": monthPage("git",
+			Message{ID: "1", Date: "2020-01-01", Subject: "Old", Author: "A"},
+			Message{ID: "2", Date: today, Subject: "New", Author: "B"},
+		),
+		"openssh": monthPage("openssh",
+			Message{ID: "3", Date: "2020-01-02", Subject: "Quiet", Author: "C"},
+		),
+		"empty":    monthPage("empty"),
+		"uncached": monthPage("uncached", Message{ID: "4", Date: today, Subject: "Fresh", Author: "D"}),
+	}
+
+	var quietFetches atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("l") == "quiet" {
+			quietFetches.Add(1)
+		}
+		page, ok := pages[r.URL.Query().Get("l")]
+		if !ok {
+			http.Error(w, "unknown list", http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, page)
+	}))
+
+	// Lists become "cached" once any month of theirs has been listed
+	if err := c.cache.SetMessages([]cache.Message{
+		{ID: "10", List: "git", Subject: "s", Author: "a", Date: "2019-12-01"},
+		{ID: "11", List: "openssh", Subject: "s", Author: "a", Date: "2019-12-01"},
+		{ID: "12", List: "empty", Subject: "s", Author: "a", Date: "2019-12-01"},
+		{ID: "13", List: "missing", Subject: "s", Author: "a", Date: "2019-12-01"},
+	}); err != nil {
+		t.Fatalf("failed to seed cache: %v", err)
+	}
+
+	since := time.Now().Add(-time.Hour)
+
+	t.Run("cached lists only", func(t *testing.T) {
+		active, err := c.ListsActiveSince(since)
+		if err != nil {
+			t.Fatalf("ListsActiveSince failed: %v", err)
+		}
+		if strings.Join(active, ",") != "git" {
+			t.Errorf("active = %v, want [git]", active)
+		}
+	})
+
+	t.Run("extra lists are fetched", func(t *testing.T) {
+		active, err := c.ListsActiveSince(since, "uncached")
+		if err != nil {
+			t.Fatalf("ListsActiveSince failed: %v", err)
+		}
+		if strings.Join(active, ",") != "git,uncached" {
+			t.Errorf("active = %v, want [git uncached]", active)
+		}
+	})
+
+	t.Run("fresh cached listing is polled live", func(t *testing.T) {
+		// The cached listing is fresh but predates the post on the stub
+		monthStart := time.Now().UTC().Format("2006-01") + "-01"
+		if err := c.cache.SetMessages([]cache.Message{
+			{ID: "20", List: "quiet", Subject: "Old", Author: "a", Date: monthStart},
+		}); err != nil {
+			t.Fatalf("failed to seed cache: %v", err)
+		}
+		pages["quiet"] = monthPage("quiet",
+			Message{ID: "21", Date: today, Subject: "New", Author: "E"},
+			Message{ID: "20", Date: monthStart, Subject: "Old", Author: "a"},
+		)
+
+		active, err := c.ListsActiveSince(since)
+		if err != nil {
+			t.Fatalf("ListsActiveSince failed: %v", err)
+		}
+		if !slices.Contains(active, "quiet") {
+			t.Errorf("active = %v, want quiet listed", active)
+		}
+		if n := quietFetches.Load(); n != 1 {
+			t.Errorf("quiet listing fetched %d times, want 1", n)
+		}
+	})
+
+	t.Run("nothing newer than since", func(t *testing.T) {
+		active, err := c.ListsActiveSince(time.Now().Add(48 * time.Hour))
+		if err != nil {
+			t.Fatalf("ListsActiveSince failed: %v", err)
+		}
+		if len(active) != 0 {
+			t.Errorf("active = %v, want none", active)
+		}
+	})
+}
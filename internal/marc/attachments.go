@@ -0,0 +1,118 @@
+package marc
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+)
+
+// Attachment describes one MIME part of a message beyond its text body.
+// Size is the decoded size in bytes.
+type Attachment struct {
+	Filename    string `json:"filename,omitempty"`
+	ContentType string `json:"content_type"`
+	Size        int    `json:"size"`
+}
+
+// maxMIMEDepth bounds how deeply nested multiparts are walked.
+const maxMIMEDepth = 8
+
+// MessageAttachments lists the attachments of a message, parsed from its
+// raw source (see GetMessageSource) since marc's rendered view drops the
+// MIME structure. Single-part messages have none.
+func (c *Client) MessageAttachments(list, messageID string) ([]Attachment, error) {
+	source, err := c.GetMessageSource(list, messageID)
+	if err != nil {
+		return nil, err
+	}
+	return parseAttachments(source), nil
+}
+
+// parseAttachments walks the multipart structure of an RFC822 message. A
+// leaf part counts as an attachment when it is named or marked
+// "attachment", or when it is not text (e.g. an inline image). The body
+// and its text alternatives do not count. Unparsable structure ends the
+// walk with whatever was found so far.
+func parseAttachments(source string) []Attachment {
+	attachments := make([]Attachment, 0)
+
+	msg, err := mail.ReadMessage(strings.NewReader(source))
+	if err != nil {
+		return attachments
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return attachments
+	}
+
+	var walk func(r *multipart.Reader, depth int)
+	walk = func(r *multipart.Reader, depth int) {
+		for {
+			part, err := r.NextRawPart()
+			if err != nil {
+				return
+			}
+
+			contentType := part.Header.Get("Content-Type")
+			if contentType == "" {
+				contentType = "text/plain"
+			}
+			mediaType, params, err := mime.ParseMediaType(contentType)
+			if err != nil {
+				mediaType = "application/octet-stream"
+			}
+
+			if strings.HasPrefix(mediaType, "multipart/") {
+				if depth < maxMIMEDepth && params["boundary"] != "" {
+					walk(multipart.NewReader(part, params["boundary"]), depth+1)
+				}
+				continue
+			}
+
+			disposition, dispParams, _ := mime.ParseMediaType(part.Header.Get("Content-Disposition"))
+			filename := dispParams["filename"]
+			if filename == "" {
+				filename = params["name"]
+			}
+			if filename == "" && disposition != "attachment" && strings.HasPrefix(mediaType, "text/") {
+				continue
+			}
+
+			attachments = append(attachments, Attachment{
+				Filename:    decodeHeaderWords(filename),
+				ContentType: mediaType,
+				Size:        decodedSize(part, part.Header.Get("Content-Transfer-Encoding")),
+			})
+		}
+	}
+	walk(multipart.NewReader(msg.Body, params["boundary"]), 1)
+
+	return attachments
+}
+
+// decodedSize returns the size of a part's content after undoing its
+// transfer encoding.
+func decodedSize(r io.Reader, encoding string) int {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		raw, err := io.ReadAll(r)
+		if err != nil {
+			return 0
+		}
+		compact := strings.Join(strings.Fields(string(raw)), "")
+		if decoded, err := base64.StdEncoding.DecodeString(compact); err == nil {
+			return len(decoded)
+		}
+		return len(raw)
+	case "quoted-printable":
+		decoded, _ := io.ReadAll(quotedprintable.NewReader(r))
+		return len(decoded)
+	default:
+		n, _ := io.Copy(io.Discard, r)
+		return int(n)
+	}
+}
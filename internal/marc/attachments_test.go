@@ -0,0 +1,92 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const multipartSource = `From: Alice <alice@example.com>
+Subject: [PATCH] fix crash
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: multipart/alternative; boundary="inner"
+
+--inner
+Content-Type: text/plain; charset=utf-8
+
+See the attached patch.
+--inner
+Content-Type: text/html; charset=utf-8
+
+<p>See the attached patch.</p>
+--inner--
+--outer
+Content-Type: text/x-patch; name="fix.patch"
+Content-Disposition: attachment; filename="fix.patch"
+
+--- a/main.c
++++ b/main.c
+--outer
+Content-Type: image/png
+Content-Transfer-Encoding: base64
+
+iVBORw0KGgo=
+--outer
+Content-Type: text/plain
+Content-Disposition: attachment; filename="=?UTF-8?Q?r=C3=A9sum=C3=A9.txt?="
+Content-Transfer-Encoding: quoted-printable
+
+caf=C3=A9
+--outer--
+`
+
+func TestParseAttachments(t *testing.T) {
+	got := parseAttachments(multipartSource)
+	want := []Attachment{
+		{Filename: "fix.patch", ContentType: "text/x-patch", Size: len("--- a/main.c\n+++ b/main.c")},
+		{ContentType: "image/png", Size: 8},
+		{Filename: "résumé.txt", ContentType: "text/plain", Size: len("café")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseAttachments() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	single := parseAttachments("From: Bob\nSubject: plain\nContent-Type: text/plain\n\nJust text.\n")
+	if single == nil || len(single) != 0 {
+		t.Errorf("expected an empty slice for a single-part message, got %#v", single)
+	}
+}
+
+func TestGetMessageIncludeAttachments(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") == "raw" {
+			_, _ = io.WriteString(w, multipartSource)
+			return
+		}
+		_, _ = io.WriteString(w, messageHTML([]string{"From: Alice", "Subject: [PATCH] fix crash"}, "See the attached patch."))
+	}))
+
+	msg, err := c.GetMessage("git", "1")
+	if err != nil {
+		t.Fatalf("GetMessage failed: %v", err)
+	}
+	if msg.Attachments != nil {
+		t.Errorf("expected no attachments unless requested, got %+v", msg.Attachments)
+	}
+
+	msg, err = c.GetMessageWithOptions("git", "1", GetMessageOptions{IncludeAttachments: true})
+	if err != nil {
+		t.Fatalf("GetMessageWithOptions failed: %v", err)
+	}
+	if len(msg.Attachments) != 3 || msg.Attachments[0].Filename != "fix.patch" {
+		t.Errorf("unexpected attachments: %+v", msg.Attachments)
+	}
+	if !strings.Contains(msg.Body, "attached patch") {
+		t.Errorf("unexpected body: %q", msg.Body)
+	}
+}
@@ -0,0 +1,57 @@
+package marc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+// AuditEntry records one tool call: which tool ran, with what arguments,
+// and how it ended.
+type AuditEntry struct {
+	Time       time.Time       `json:"time"`
+	Tool       string          `json:"tool"`
+	Arguments  json.RawMessage `json:"arguments"`
+	Success    bool            `json:"success"`
+	Error      string          `json:"error,omitempty"`
+	DurationMS int64           `json:"duration_ms"`
+}
+
+// RecordAudit stores a tool call in the cache's audit log.
+func (c *Client) RecordAudit(e AuditEntry) error {
+	args := string(e.Arguments)
+	if args == "" {
+		args = "{}"
+	}
+
+	return c.cache.RecordAudit(cache.AuditEntry{
+		Time:      e.Time,
+		Tool:      e.Tool,
+		Arguments: args,
+		Success:   e.Success,
+		Error:     e.Error,
+		Duration:  time.Duration(e.DurationMS) * time.Millisecond,
+	})
+}
+
+// AuditTail returns the most recent audit log entries, newest first.
+func (c *Client) AuditTail(limit int) ([]AuditEntry, error) {
+	entries, err := c.cache.AuditTail(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]AuditEntry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, AuditEntry{
+			Time:       e.Time,
+			Tool:       e.Tool,
+			Arguments:  json.RawMessage(e.Arguments),
+			Success:    e.Success,
+			Error:      e.Error,
+			DurationMS: e.Duration.Milliseconds(),
+		})
+	}
+	return out, nil
+}
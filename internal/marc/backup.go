@@ -0,0 +1,23 @@
+package marc
+
+import (
+	"io"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+// ErrUnsupportedExport is returned by ImportCache for data that is not a
+// cache export or comes from a newer, incompatible version.
+var ErrUnsupportedExport = cache.ErrExportVersion
+
+// ExportCache writes the whole cache to w as a versioned JSON dump for
+// ImportCache, e.g. to move a warmed cache to another machine.
+func (c *Client) ExportCache(w io.Writer) error {
+	return c.cache.Export(w)
+}
+
+// ImportCache restores a dump written by ExportCache. Imported entries
+// replace cached ones with the same key and keep their original age.
+func (c *Client) ImportCache(r io.Reader) error {
+	return c.cache.Import(r)
+}
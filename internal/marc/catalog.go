@@ -0,0 +1,111 @@
+package marc
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// catalogDocumentKey is the documents cache key of the catalog.
+const catalogDocumentKey = "catalog"
+
+// UncategorizedCategory is the Category of the CategoryLists bucket holding
+// lists filed under no category.
+const UncategorizedCategory = "(uncategorized)"
+
+// CategoryLists is one category of the mailing-list catalog together with
+// the lists filed under it.
+type CategoryLists struct {
+	Category  string        `json:"category"`
+	ListCount int           `json:"list_count"`
+	Lists     []MailingList `json:"lists"`
+}
+
+// GroupMailingLists nests lists under their categories, sorted by category
+// and then by list name. Lists with an empty category go to the
+// UncategorizedCategory bucket.
+func GroupMailingLists(lists []MailingList) []CategoryLists {
+	return groupMailingLists(lists, true)
+}
+
+// groupMailingLists nests lists under their categories, in the order in
+// which categories and lists first appear unless sorted is set.
+func groupMailingLists(lists []MailingList, sorted bool) []CategoryLists {
+	index := make(map[string]int)
+	groups := make([]CategoryLists, 0)
+
+	for _, l := range lists {
+		category := l.Category
+		if category == "" {
+			category = UncategorizedCategory
+		}
+		i, ok := index[category]
+		if !ok {
+			i = len(groups)
+			index[category] = i
+			groups = append(groups, CategoryLists{Category: category})
+		}
+		groups[i].Lists = append(groups[i].Lists, l)
+		groups[i].ListCount++
+	}
+
+	if !sorted {
+		return groups
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Category < groups[j].Category
+	})
+	for _, g := range groups {
+		sort.SliceStable(g.Lists, func(i, j int) bool {
+			return g.Lists[i].Name < g.Lists[j].Name
+		})
+	}
+	return groups
+}
+
+// Catalog is the marc.info list index in the order marc presents it.
+type Catalog struct {
+	Categories    []CategoryLists `json:"categories"`
+	CategoryCount int             `json:"category_count"`
+	ListCount     int             `json:"list_count"`
+}
+
+// GetCatalog returns the whole list index, categories and lists in marc's
+// order, from a single fetch. The catalog is cached as one document; the
+// flat list cache used by ListMailingLists is refreshed along with it.
+// Served stale, the catalog is rebuilt from the cached lists, which are
+// sorted by category and name as marc's order is not kept.
+func (c *Client) GetCatalog() (*Catalog, error) {
+	if cached, ok := c.cache.GetDocument("", catalogDocumentKey); ok {
+		var catalog Catalog
+		if err := json.Unmarshal([]byte(cached), &catalog); err == nil {
+			return &catalog, nil
+		}
+		c.logger.Debug("ignoring undecodable cached catalog")
+	}
+
+	doc, err := c.fetch("")
+	if err != nil {
+		if stale, ok := c.staleMailingLists(err); ok {
+			return buildCatalog(stale), nil
+		}
+		return nil, err
+	}
+
+	lists := parseMailingLists(doc, c.logger)
+	c.storeMailingLists(lists)
+
+	catalog := buildCatalog(lists)
+	c.logger.Debug("built catalog", "categories", catalog.CategoryCount, "lists", catalog.ListCount)
+
+	if data, err := json.Marshal(catalog); err == nil {
+		c.cache.SetDocument("", catalogDocumentKey, string(data))
+	}
+	return catalog, nil
+}
+
+// buildCatalog groups lists by category, keeping the order in which
+// categories and lists first appear.
+func buildCatalog(lists []MailingList) *Catalog {
+	categories := groupMailingLists(lists, false)
+	return &Catalog{Categories: categories, CategoryCount: len(categories), ListCount: len(lists)}
+}
@@ -0,0 +1,115 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGroupMailingLists(t *testing.T) {
+	lists := []MailingList{
+		{Name: "linux-kernel", Category: "Linux"},
+		{Name: "git", Category: "Development"},
+		{Name: "orphan", Category: ""},
+		{Name: "bugtraq", Category: "Security"},
+		{Name: "cvs", Category: "Development"},
+		{Name: "linux-mm", Category: "Linux"},
+		{Name: "another-orphan", Category: ""},
+	}
+
+	got := GroupMailingLists(lists)
+
+	want := []CategoryLists{
+		{Category: UncategorizedCategory, ListCount: 2, Lists: []MailingList{
+			{Name: "another-orphan"},
+			{Name: "orphan"},
+		}},
+		{Category: "Development", ListCount: 2, Lists: []MailingList{
+			{Name: "cvs", Category: "Development"},
+			{Name: "git", Category: "Development"},
+		}},
+		{Category: "Linux", ListCount: 2, Lists: []MailingList{
+			{Name: "linux-kernel", Category: "Linux"},
+			{Name: "linux-mm", Category: "Linux"},
+		}},
+		{Category: "Security", ListCount: 1, Lists: []MailingList{
+			{Name: "bugtraq", Category: "Security"},
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupMailingLists() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestGroupMailingListsEmpty(t *testing.T) {
+	got := GroupMailingLists(nil)
+	if got == nil || len(got) != 0 {
+		t.Errorf("expected an empty, non-nil catalog, got %#v", got)
+	}
+}
+
+func TestGetCatalog(t *testing.T) {
+	index := `<html><body><dl>
+<dd><a href="?l=announce&w=2">announce</a> - Site news</dd>
+<dt><b><img alt="Group: " src="group.gif"> Linux</b></dt>
+<dd><a href="?l=linux-mm&w=2">linux-mm</a></dd>
+<dd><a href="?l=linux-kernel&w=2">linux-kernel</a> - LKML <a href="mailto:linux-kernel@vger.kernel.org">post</a></dd>
+<dt><b><img alt="Group: " src="group.gif"> Development</b></dt>
+<dd><a href="?l=git&w=2">git</a></dd>
+<dt><b><img alt="Group: " src="group.gif"> Security</b></dt>
+<dd><a href="?l=bugtraq&w=2">bugtraq</a></dd>
+<dd><a href="?l=full-disclosure&w=2">full-disclosure</a></dd>
+</dl></body></html>`
+
+	var requests int
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = io.WriteString(w, index)
+	}))
+
+	catalog, err := c.GetCatalog()
+	if err != nil {
+		t.Fatalf("GetCatalog failed: %v", err)
+	}
+
+	want := &Catalog{
+		CategoryCount: 4,
+		ListCount:     6,
+		Categories: []CategoryLists{
+			{Category: UncategorizedCategory, ListCount: 1, Lists: []MailingList{
+				{Name: "announce", Description: "Site news"},
+			}},
+			// marc's order, not alphabetical
+			{Category: "Linux", ListCount: 2, Lists: []MailingList{
+				{Name: "linux-mm", Category: "Linux"},
+				{Name: "linux-kernel", Category: "Linux", Description: "LKML", PostAddress: "linux-kernel@vger.kernel.org"},
+			}},
+			{Category: "Development", ListCount: 1, Lists: []MailingList{
+				{Name: "git", Category: "Development"},
+			}},
+			{Category: "Security", ListCount: 2, Lists: []MailingList{
+				{Name: "bugtraq", Category: "Security"},
+				{Name: "full-disclosure", Category: "Security"},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(catalog, want) {
+		t.Errorf("GetCatalog() =\n%+v\nwant\n%+v", catalog, want)
+	}
+
+	// The catalog and the flat list cache are both served without fetching
+	cached, err := c.GetCatalog()
+	if err != nil {
+		t.Fatalf("cached GetCatalog failed: %v", err)
+	}
+	if !reflect.DeepEqual(cached, want) {
+		t.Errorf("cached catalog differs:\n%+v", cached)
+	}
+	if lists, err := c.ListMailingLists(); err != nil || len(lists) != 6 {
+		t.Errorf("ListMailingLists() = %d lists, %v; want 6", len(lists), err)
+	}
+	if requests != 1 {
+		t.Errorf("expected a single index fetch, got %d", requests)
+	}
+}
@@ -1,6 +1,7 @@
 package marc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -10,26 +11,139 @@ import (
 	"net/url"
 	"os"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/andr1an/marc-mcp/internal/cache"
+	"github.com/andr1an/marc-mcp/internal/logctx"
 	"golang.org/x/net/html"
 )
 
 const (
-	baseURL = "https://marc.info/"
+	defaultBaseURL = "https://marc.info/"
 
 	defaultTimeout  = 2 * time.Minute
 	minTimeout      = 10 * time.Second
 	maxTimeout      = 15 * time.Minute
 	maxFetchRetries = 3
+
+	// defaultDialTimeout bounds connecting (DNS, TCP and TLS handshake)
+	// separately from the overall request timeout, so an unreachable host
+	// fails well before a slow download would.
+	defaultDialTimeout = 10 * time.Second
 )
 
 type Client struct {
-	http   *http.Client
-	cache  *cache.Cache
-	logger *slog.Logger
+	baseURL   string
+	conn      *connState
+	pageSizes *pageSizeCache
+	// monthPages tracks which listing pages back the cached months.
+	monthPages *monthPages
+	// listRedirects are list renames learned from marc.info redirects.
+	listRedirects *listRedirects
+	cache         *cache.Cache
+	logger        *slog.Logger
+	// ctx bounds every fetch; nil means context.Background (see WithContext).
+	ctx context.Context
+
+	// serveStale makes failed fetches fall back to expired cache entries.
+	serveStale bool
+	// listAliases maps alternative list names to marc's (MARC_LIST_ALIASES).
+	listAliases map[string]string
+}
+
+// Config holds the settings that can be changed at runtime via Reconfigure.
+type Config struct {
+	Timeout time.Duration
+	// RateLimit caps requests to marc.info per second; 0 disables it.
+	RateLimit float64
+}
+
+// connState is the reconfigurable part of a Client. It is shared by
+// pointer so every user of the Client sees a swap at once.
+type connState struct {
+	mu        sync.RWMutex
+	http      *http.Client
+	limiter   *rateLimiter
+	rateLimit float64
+}
+
+func newConnState(httpClient *http.Client, rateLimit float64) *connState {
+	return &connState{
+		http:      httpClient,
+		limiter:   newRateLimiter(rateLimit),
+		rateLimit: rateLimit,
+	}
+}
+
+func (s *connState) get() (*http.Client, *rateLimiter) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.http, s.limiter
+}
+
+// Config returns the client's current runtime settings.
+func (c *Client) Config() Config {
+	c.conn.mu.RLock()
+	defer c.conn.mu.RUnlock()
+	return Config{Timeout: c.conn.http.Timeout, RateLimit: c.conn.rateLimit}
+}
+
+// Reconfigure swaps the HTTP client and rate limiter. A throttling
+// slowdown in effect carries over to the new rate limit. Requests already
+// in flight finish with the settings they started with.
+func (c *Client) Reconfigure(cfg Config) error {
+	if cfg.Timeout < minTimeout || cfg.Timeout > maxTimeout {
+		return fmt.Errorf("timeout %s out of range %s to %s", cfg.Timeout, minTimeout, maxTimeout)
+	}
+	if cfg.RateLimit < 0 {
+		return fmt.Errorf("rate limit must not be negative, got %v", cfg.RateLimit)
+	}
+
+	c.conn.mu.Lock()
+	defer c.conn.mu.Unlock()
+
+	c.conn.http = &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: c.conn.http.Transport,
+	}
+	c.conn.limiter = c.conn.limiter.retuned(cfg.RateLimit)
+	c.conn.rateLimit = cfg.RateLimit
+
+	c.logger.Info("client reconfigured", "timeout", cfg.Timeout, "rate_limit", cfg.RateLimit)
+	return nil
+}
+
+// getRateLimit reads MARC_RATE_LIMIT (requests per second, 0 = unlimited).
+func getRateLimit() float64 {
+	v, err := strconv.ParseFloat(os.Getenv("MARC_RATE_LIMIT"), 64)
+	if err != nil || v < 0 {
+		return 0
+	}
+	return v
+}
+
+// getDialTimeout reads MARC_DIAL_TIMEOUT, falling back to the default for
+// missing or invalid values.
+func getDialTimeout() time.Duration {
+	d, err := time.ParseDuration(os.Getenv("MARC_DIAL_TIMEOUT"))
+	if err != nil || d <= 0 {
+		return defaultDialTimeout
+	}
+	return d
+}
+
+// newTransport returns the default transport with connection setup bounded
+// by dialTimeout. The overall request deadline stays with http.Client.
+func newTransport(dialTimeout time.Duration) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	dialer := &net.Dialer{Timeout: dialTimeout, KeepAlive: 30 * time.Second}
+	t.DialContext = dialer.DialContext
+	t.TLSHandshakeTimeout = dialTimeout
+	return t
 }
 
 func getTimeout() time.Duration {
@@ -52,6 +166,96 @@ func getTimeout() time.Duration {
 	return d
 }
 
+// parseListTTL parses MARC_LIST_TTL-style overrides ("linux-kernel=10m,git=1h").
+// Malformed entries are skipped.
+func parseListTTL(spec string, logger *slog.Logger) map[string]time.Duration {
+	overrides := make(map[string]time.Duration)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			logger.Warn("ignoring malformed list TTL override", "entry", entry)
+			continue
+		}
+
+		ttl, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || ttl <= 0 {
+			logger.Warn("ignoring invalid list TTL override", "entry", entry)
+			continue
+		}
+		overrides[name] = ttl
+	}
+	return overrides
+}
+
+// parseListAliases parses MARC_LIST_ALIASES-style renames ("old=new,foo=bar").
+// Malformed entries are skipped, as are entries whose target is itself an
+// alias, so resolving a name twice gives the same result.
+func parseListAliases(spec string, logger *slog.Logger) map[string]string {
+	aliases := make(map[string]string)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		alias, list, ok := strings.Cut(entry, "=")
+		alias, list = strings.TrimSpace(alias), strings.TrimSpace(list)
+		if !ok || alias == "" || list == "" {
+			logger.Warn("ignoring malformed list alias", "entry", entry)
+			continue
+		}
+		aliases[alias] = list
+	}
+	for alias, list := range aliases {
+		if _, chained := aliases[list]; chained {
+			logger.Warn("ignoring chained list alias", "alias", alias, "list", list)
+			delete(aliases, alias)
+		}
+	}
+	return aliases
+}
+
+// normalizeList returns the marc name of list, resolving MARC_LIST_ALIASES
+// and then renames learned from marc.info redirects.
+func (c *Client) normalizeList(list string) string {
+	if canonical, ok := c.listAliases[list]; ok {
+		c.logger.Debug("rewriting list alias", "alias", list, "list", canonical)
+		list = canonical
+	}
+	if canonical, ok := c.redirectedList(list); ok {
+		c.logger.Debug("rewriting redirected list", "requested", list, "list", canonical)
+		list = canonical
+	}
+	return list
+}
+
+// parsePragmas parses MARC_CACHE_PRAGMAS ("cache_size=-20000,mmap_size=268435456").
+// Keys and values are validated by the cache.
+func parsePragmas(spec string, logger *slog.Logger) map[string]string {
+	pragmas := make(map[string]string)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(entry, "=")
+		name, value = strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(value)
+		if !ok || name == "" || value == "" {
+			logger.Warn("ignoring malformed cache pragma", "entry", entry)
+			continue
+		}
+		pragmas[name] = value
+	}
+	return pragmas
+}
+
 func NewClient() (*Client, error) {
 	logger := slog.Default().With("component", "marc")
 
@@ -66,15 +270,43 @@ func NewClient() (*Client, error) {
 		}
 	}
 
+	if listTTLEnv := os.Getenv("MARC_LIST_TTL"); listTTLEnv != "" {
+		opts.ListTTL = parseListTTL(listTTLEnv, logger)
+	}
+
+	if pragmasEnv := os.Getenv("MARC_CACHE_PRAGMAS"); pragmasEnv != "" {
+		opts.Pragmas = parsePragmas(pragmasEnv, logger)
+	}
+
+	opts.ReadOnly, _ = strconv.ParseBool(os.Getenv("MARC_CACHE_READONLY"))
+
 	c, err := cache.New(opts)
 	if err != nil {
 		return nil, fmt.Errorf("init cache: %w", err)
 	}
 
+	serveStale, _ := strconv.ParseBool(os.Getenv("MARC_SERVE_STALE"))
+
+	var listAliases map[string]string
+	if aliasesEnv := os.Getenv("MARC_LIST_ALIASES"); aliasesEnv != "" {
+		listAliases = parseListAliases(aliasesEnv, logger)
+	}
+
+	httpClient := &http.Client{
+		Timeout:   getTimeout(),
+		Transport: newTransport(getDialTimeout()),
+	}
+
 	return &Client{
-		http:   &http.Client{Timeout: getTimeout()},
-		cache:  c,
-		logger: logger,
+		baseURL:       defaultBaseURL,
+		conn:          newConnState(httpClient, getRateLimit()),
+		pageSizes:     newPageSizeCache(),
+		monthPages:    newMonthPages(),
+		listRedirects: newListRedirects(),
+		cache:         c,
+		logger:        logger,
+		serveStale:    serveStale,
+		listAliases:   listAliases,
 	}, nil
 }
 
@@ -82,9 +314,37 @@ func (c *Client) Close() error {
 	return c.cache.Close()
 }
 
+// WithContext returns a Client whose fetches are cancelled once ctx is done,
+// including rate limit waits and retry backoff. With a request-scoped
+// logger in ctx (see logctx) it also logs through that logger, so its log
+// lines, including the cache's, carry the request's correlation ID. It
+// shares everything else with c.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	scoped := *c
+	scoped.ctx = ctx
+	if logger := logctx.FromContext(ctx); logger != nil {
+		scoped.logger = logger.With("component", "marc")
+		scoped.cache = c.cache.WithLogger(scoped.logger)
+	}
+	return &scoped
+}
+
+// fetchContext returns the context bounding c's fetches.
+func (c *Client) fetchContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 type MailingList struct {
 	Name     string `json:"name"`
 	Category string `json:"category"`
+	// Description and PostAddress are only set when the index shows them
+	// next to the list link.
+	Description string `json:"description,omitempty"`
+	PostAddress string `json:"post_address,omitempty"`
+	Stale       bool   `json:"stale,omitempty"` // see Message.Stale
 }
 
 type Message struct {
@@ -93,16 +353,34 @@ type Message struct {
 	Author  string `json:"author"`
 	Date    string `json:"date"`
 	List    string `json:"list"`
+	// Stale marks data served from an expired cache entry because
+	// marc.info could not be reached (MARC_SERVE_STALE).
+	Stale bool `json:"stale,omitempty"`
+	// Patch is set when the subject is tagged as part of a patch series.
+	Patch *PatchInfo `json:"patch,omitempty"`
+}
+
+// messageFromCache converts a cached message, deriving the fields that are
+// not stored.
+func messageFromCache(cm cache.Message) Message {
+	return Message{ID: cm.ID, List: cm.List, Subject: cm.Subject, Author: cm.Author, Date: cm.Date, Patch: patchInfo(cm.Subject)}
 }
 
 type MessageContent struct {
 	Message
 	Body    string            `json:"body"`
 	Headers map[string]string `json:"headers"`
+	// Attachments is only filled in on request (see GetMessageOptions),
+	// and is then non-nil even for single-part messages.
+	Attachments []Attachment `json:"attachments,omitzero"`
+	// Fingerprint is a SHA-256 over the Message-ID, From, Subject and Date
+	// headers and the trimmed body, for deduplication and change
+	// detection. It is stored with the cached message.
+	Fingerprint string `json:"fingerprint,omitempty"`
 }
 
 func (c *Client) fetch(path string) (*html.Node, error) {
-	body, err := c.fetchWithRetry(path)
+	body, _, err := c.fetchWithRetry(path)
 	if err != nil {
 		return nil, err
 	}
@@ -110,54 +388,98 @@ func (c *Client) fetch(path string) (*html.Node, error) {
 }
 
 func (c *Client) fetchRaw(path string) (string, error) {
-	return c.fetchWithRetry(path)
+	body, _, err := c.fetchWithRetry(path)
+	return body, err
 }
 
-func (c *Client) fetchWithRetry(path string) (string, error) {
-	fullURL := baseURL + path
+// fetchWithRetry returns the response body along with the final URL after
+// any redirects.
+func (c *Client) fetchWithRetry(path string) (string, *url.URL, error) {
+	fullURL := c.baseURL + path
+	ctx := c.fetchContext()
+	httpClient, limiter := c.conn.get()
 	var lastErr error
 
 	for attempt := 1; attempt <= maxFetchRetries; attempt++ {
 		c.logger.Debug("fetching", "url", fullURL, "attempt", attempt)
 
-		resp, err := c.http.Get(fullURL)
+		if err := limiter.Wait(ctx); err != nil {
+			return "", nil, fmt.Errorf("fetch cancelled: %w", err)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+		if err != nil {
+			return "", nil, fmt.Errorf("fetch failed: %w", err)
+		}
+		resp, err := httpClient.Do(req)
 		if err != nil {
-			lastErr = fmt.Errorf("fetch failed: %w", err)
-			if !isRetryableHTTPError(err) || attempt == maxFetchRetries {
+			lastErr = fmt.Errorf("fetch failed: %w", &unavailableError{err})
+			if ctx.Err() != nil || !isRetryableHTTPError(err) || attempt == maxFetchRetries {
 				c.logger.Debug("fetch failed", "url", fullURL, "attempt", attempt, "error", err)
-				return "", lastErr
+				return "", nil, lastErr
+			}
+			if err := sleepContext(ctx, backoffDelay(attempt)); err != nil {
+				return "", nil, lastErr
 			}
-			time.Sleep(backoffDelay(attempt))
 			continue
 		}
 
 		body, readErr := io.ReadAll(resp.Body)
 		_ = resp.Body.Close()
 		if readErr != nil {
-			lastErr = fmt.Errorf("read failed: %w", readErr)
-			if attempt == maxFetchRetries {
-				return "", lastErr
+			lastErr = fmt.Errorf("read failed: %w", &unavailableError{readErr})
+			if ctx.Err() != nil || attempt == maxFetchRetries {
+				return "", nil, lastErr
+			}
+			if err := sleepContext(ctx, backoffDelay(attempt)); err != nil {
+				return "", nil, lastErr
 			}
-			time.Sleep(backoffDelay(attempt))
 			continue
 		}
 
 		c.logger.Debug("response", "status", resp.StatusCode, "url", fullURL)
-		if resp.StatusCode == http.StatusOK {
-			return string(body), nil
+
+		// Slow every later request down, not just this one's retries
+		wait, throttled := throttleHint(resp.StatusCode, resp.Header, string(body))
+		if throttled {
+			c.logger.Warn("marc.info is throttling, slowing down", "url", fullURL, "status", resp.StatusCode, "wait", wait)
+			limiter.Throttle(wait)
+		}
+
+		if resp.StatusCode == http.StatusOK && !throttled {
+			return string(body), resp.Request.URL, nil
 		}
 
 		lastErr = fmt.Errorf("unexpected status: %d", resp.StatusCode)
-		if !isRetryableStatus(resp.StatusCode) || attempt == maxFetchRetries {
-			return "", fmt.Errorf("%w for %s", lastErr, fullURL)
+		if resp.StatusCode == http.StatusOK {
+			lastErr = errors.New("throttled by marc.info")
+		}
+		retryable := throttled || isRetryableStatus(resp.StatusCode)
+		if retryable {
+			lastErr = &unavailableError{lastErr}
+		}
+		if !retryable || attempt == maxFetchRetries {
+			return "", nil, fmt.Errorf("%w for %s", lastErr, fullURL)
 		}
 
-		time.Sleep(backoffDelay(attempt))
+		if err := sleepContext(ctx, backoffDelay(attempt)); err != nil {
+			return "", nil, fmt.Errorf("%w for %s", lastErr, fullURL)
+		}
 	}
 
-	return "", fmt.Errorf("%w for %s", lastErr, fullURL)
+	return "", nil, fmt.Errorf("%w for %s", lastErr, fullURL)
+}
+
+// unavailableError marks a fetch that failed because marc.info could not be
+// reached or answered with a status worth retrying, as opposed to one that
+// failed because of what was asked.
+type unavailableError struct {
+	err error
 }
 
+func (e *unavailableError) Error() string { return e.err.Error() }
+
+func (e *unavailableError) Unwrap() error { return e.err }
+
 func isRetryableHTTPError(err error) bool {
 	var netErr net.Error
 	if errors.As(err, &netErr) {
@@ -184,16 +506,65 @@ func (c *Client) ListMailingLists() ([]MailingList, error) {
 	if cached, ok := c.cache.GetMailingLists(); ok {
 		lists := make([]MailingList, len(cached))
 		for i, cl := range cached {
-			lists[i] = MailingList{Name: cl.Name, Category: cl.Category}
+			lists[i] = mailingListFromCache(cl)
 		}
 		return lists, nil
 	}
 
 	doc, err := c.fetch("")
 	if err != nil {
+		if stale, ok := c.staleMailingLists(err); ok {
+			return stale, nil
+		}
 		return nil, err
 	}
 
+	lists := parseMailingLists(doc, c.logger)
+	c.logger.Debug("found mailing lists", "count", len(lists))
+
+	c.storeMailingLists(lists)
+	return lists, nil
+}
+
+func (c *Client) storeMailingLists(lists []MailingList) {
+	cacheLists := make([]cache.MailingList, len(lists))
+	for i, l := range lists {
+		cacheLists[i] = cache.MailingList{
+			Name:        l.Name,
+			Category:    l.Category,
+			Description: l.Description,
+			PostAddress: l.PostAddress,
+		}
+	}
+	c.cache.SetMailingLists(cacheLists)
+}
+
+// ListCategory returns the category a mailing list is filed under. On a
+// cache miss the list catalog is fetched (and cached) first. found is false
+// for lists marc.info does not know.
+func (c *Client) ListCategory(name string) (category string, found bool, err error) {
+	name = c.normalizeList(name)
+
+	if category, ok := c.cache.GetListCategory(name); ok {
+		return category, true, nil
+	}
+
+	lists, err := c.ListMailingLists()
+	if err != nil {
+		return "", false, err
+	}
+
+	for _, l := range lists {
+		if l.Name == name {
+			return l.Category, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// parseMailingLists walks the marc.info index and returns every list link
+// together with the category heading it appears under.
+func parseMailingLists(doc *html.Node, logger *slog.Logger) []MailingList {
 	var lists []MailingList
 	var currentCategory string
 
@@ -204,20 +575,22 @@ func (c *Client) ListMailingLists() ([]MailingList, error) {
 			cat := extractCategory(n)
 			if cat != "" {
 				currentCategory = cat
-				c.logger.Debug("found category", "name", currentCategory)
+				logger.Debug("found category", "name", currentCategory)
 			}
 		}
 
 		if n.Type == html.ElementNode && n.Data == "a" {
-			href := getAttr(n, "href")
-			if strings.HasPrefix(href, "?l=") {
-				listName := extractListName(href)
-				if listName != "" {
-					lists = append(lists, MailingList{
-						Name:     listName,
-						Category: currentCategory,
-					})
-				}
+			// Accepts relative (?l=), path-prefixed (/?l=) and absolute
+			// (https://marc.info/?l=) forms alike
+			listName := extractListName(getAttr(n, "href"))
+			if listName != "" {
+				description, postAddress := listEntryDetails(n)
+				lists = append(lists, MailingList{
+					Name:        listName,
+					Category:    currentCategory,
+					Description: description,
+					PostAddress: postAddress,
+				})
 			}
 		}
 
@@ -227,16 +600,54 @@ func (c *Client) ListMailingLists() ([]MailingList, error) {
 	}
 	walk(doc)
 
-	c.logger.Debug("found mailing lists", "count", len(lists))
+	return lists
+}
 
-	// Store in cache
-	cacheLists := make([]cache.MailingList, len(lists))
-	for i, l := range lists {
-		cacheLists[i] = cache.MailingList{Name: l.Name, Category: l.Category}
+func mailingListFromCache(cl cache.MailingList) MailingList {
+	return MailingList{
+		Name:        cl.Name,
+		Category:    cl.Category,
+		Description: cl.Description,
+		PostAddress: cl.PostAddress,
 	}
-	c.cache.SetMailingLists(cacheLists)
+}
 
-	return lists, nil
+// listEntryDetails reads the description and posting address that follow
+// a list link in the index, as in
+// <dd><a href="?l=git">git</a> - Git SCM <a href="mailto:git@vger.kernel.org">post</a></dd>.
+// The entry ends with its parent element, a <br> or the next list link.
+// Either value is empty when the entry has none.
+func listEntryDetails(link *html.Node) (description, postAddress string) {
+	var text []string
+	for n := link.NextSibling; n != nil; n = n.NextSibling {
+		if n.Type == html.TextNode {
+			text = append(text, n.Data)
+			continue
+		}
+		if n.Type != html.ElementNode {
+			continue
+		}
+		if n.Data == "br" {
+			break
+		}
+		if n.Data == "a" {
+			href := getAttr(n, "href")
+			if addr, ok := strings.CutPrefix(href, "mailto:"); ok {
+				if postAddress == "" {
+					postAddress, _, _ = strings.Cut(addr, "?")
+				}
+				continue
+			}
+			if extractListName(href) != "" {
+				break
+			}
+		}
+		text = append(text, extractText(n))
+	}
+
+	description = strings.Join(strings.Fields(strings.Join(text, " ")), " ")
+	description = strings.TrimSpace(strings.TrimLeft(description, "-–—:"))
+	return description, postAddress
 }
 
 func extractCategory(dt *html.Node) string {
@@ -274,6 +685,16 @@ type ListMessagesOptions struct {
 	Month string // YYYYMM format
 	Page  int    // Page number (1-based, default 1)
 	Limit int    // Max messages to return (0 = all)
+
+	// Drop messages whose author or subject contains any of these
+	// (case-insensitive). Applied before Limit.
+	ExcludeAuthors  []string
+	ExcludeSubjects []string
+
+	// Order is OrderDesc (newest first, the default) or OrderAsc. It
+	// orders the messages returned; which pages are fetched and which
+	// messages Limit keeps are unaffected.
+	Order string
 }
 
 func (c *Client) ListMessages(list string, month string) ([]Message, error) {
@@ -284,36 +705,307 @@ func (c *Client) ListMessages(list string, month string) ([]Message, error) {
 }
 
 func (c *Client) ListMessagesWithOptions(opts ListMessagesOptions) ([]Message, error) {
+	page, err := c.ListMessagesPage(opts)
+	if err != nil {
+		return nil, err
+	}
+	return page.Messages, nil
+}
+
+// messagesPerPage is how many messages marc.info is assumed to show per
+// listing page until a full page has been seen (see DetectPageSize).
+const messagesPerPage = 30
+
+// MessagePage is one page of a month listing along with where to continue.
+type MessagePage struct {
+	// List and Month are the resolved listing, after defaults are applied.
+	List     string
+	Month    string
+	Messages []Message
+	// RequestedList is the list name asked for when it differs from List,
+	// i.e. it was an alias or marc.info redirected it.
+	RequestedList string
+	// NextPage is the page number that follows, or 0 on the last page.
+	NextPage int
+
+	// Empty is set when the fetched page listed no messages at all, i.e.
+	// the list exists but has nothing for the month (or past this page).
+	Empty bool
+	// ParsedFrom is the size in bytes of the fetched page, or 0 when the
+	// messages came from the cache.
+	ParsedFrom int
+	// Diagnostic is set when the page linked to messages but none could be
+	// parsed, which points at a scraping problem rather than an empty month.
+	Diagnostic string
+}
+
+// ListMessagesPage is ListMessagesWithOptions that also reports whether the
+// month has further pages.
+func (c *Client) ListMessagesPage(opts ListMessagesOptions) (*MessagePage, error) {
+	requested := opts.List
+	opts, err := c.resolveListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	page, err := c.listMessagesPage(opts)
+	if err != nil {
+		return nil, err
+	}
+	if page.List != requested {
+		page.RequestedList = requested
+	}
+	return page, nil
+}
+
+// listMessagesPage is ListMessagesPage for resolved options.
+func (c *Client) listMessagesPage(opts ListMessagesOptions) (*MessagePage, error) {
+	c.logger.Debug("listing messages", "list", opts.List, "month", opts.Month, "page", opts.Page, "limit", opts.Limit)
+
+	// Check cache first (only for first page without limit). The cache
+	// holds whatever pages were fetched for the month, so it stands in for
+	// page 1 only when those are pages 1..n fetched in order; the cursor
+	// then resumes after them.
+	if opts.Page == 1 && opts.Limit == 0 {
+		if cached, ok := c.cache.GetMessages(opts.List, opts.Month); ok {
+			if next, ok := c.cachedPagesNext(opts.List, opts.Month, cached); ok {
+				messages := make([]Message, len(cached))
+				for i, cm := range cached {
+					messages[i] = messageFromCache(cm)
+				}
+				messages = excludeMessages(messages, opts)
+				sortMessages(messages, opts.Order)
+				return &MessagePage{List: opts.List, Month: opts.Month, Messages: messages, NextPage: next}, nil
+			}
+		}
+	}
+
+	listing, err := c.fetchListingPage(opts.List, opts.Month, opts.Page)
+	if err != nil {
+		// Cached listings are per month, so only page 1 can be served stale
+		if opts.Page == 1 {
+			if stale, ok := c.staleMessages(opts.List, opts.Month, err); ok {
+				stale = excludeMessages(stale, opts)
+				if opts.Limit > 0 && len(stale) > opts.Limit {
+					stale = stale[:opts.Limit]
+				}
+				sortMessages(stale, opts.Order)
+				return &MessagePage{List: opts.List, Month: opts.Month, Messages: stale}, nil
+			}
+		}
+		return nil, err
+	}
+
+	// Cache the full page; exclusions and limit only shape this response
+	c.storeMessages(listing.messages)
+
+	messages := excludeMessages(listing.messages, opts)
+
+	// Apply limit if specified
+	if opts.Limit > 0 && len(messages) > opts.Limit {
+		messages = messages[:opts.Limit]
+	}
+	sortMessages(messages, opts.Order)
+
+	result := &MessagePage{
+		List:       listing.list,
+		Month:      opts.Month,
+		Messages:   messages,
+		Empty:      len(listing.messages) == 0 && listing.unparsed == 0,
+		ParsedFrom: listing.size,
+	}
+	if listing.unparsed > 0 {
+		result.Diagnostic = fmt.Sprintf("no messages parsed from a %d-byte page with %d message links", listing.size, listing.unparsed)
+	}
+	if listing.hasNext {
+		result.NextPage = opts.Page + 1
+	}
+	return result, nil
+}
+
+// excludeMessages drops messages matching opts.ExcludeAuthors or
+// opts.ExcludeSubjects using case-insensitive substring matching.
+func excludeMessages(messages []Message, opts ListMessagesOptions) []Message {
+	if len(opts.ExcludeAuthors) == 0 && len(opts.ExcludeSubjects) == 0 {
+		return messages
+	}
+
+	kept := make([]Message, 0, len(messages))
+	for _, m := range messages {
+		if containsAnyFold(m.Author, opts.ExcludeAuthors) || containsAnyFold(m.Subject, opts.ExcludeSubjects) {
+			continue
+		}
+		kept = append(kept, m)
+	}
+	return kept
+}
+
+func containsAnyFold(s string, patterns []string) bool {
+	s = strings.ToLower(s)
+	for _, p := range patterns {
+		if p != "" && strings.Contains(s, strings.ToLower(p)) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxMonthPages bounds how many pages ListAllMessages walks for one month.
+const maxMonthPages = 50
+
+// PageFunc receives each page of messages as ListAllMessages fetches it.
+type PageFunc func(page int, messages []Message) error
+
+// ListAllMessages walks the pages of a month starting at opts.Page, calling
+// onPage (if non-nil) as each page arrives, and returns the accumulated
+// messages. opts.Limit caps the total across all pages.
+func (c *Client) ListAllMessages(opts ListMessagesOptions, onPage PageFunc) ([]Message, error) {
+	result, err := c.ListAllMessagesContext(context.Background(), opts, onPage)
+	if err != nil {
+		return nil, err
+	}
+	return result.Messages, nil
+}
+
+// AllMessagesResult is the outcome of ListAllMessagesContext. TimedOut is
+// set when the context ended before the last page; List, Month and NextPage
+// then locate the first page that was not fetched.
+type AllMessagesResult struct {
+	Messages []Message
+	TimedOut bool
+	List     string
+	Month    string
+	NextPage int
+}
+
+// ListAllMessagesContext is ListAllMessages that stops once ctx is done and
+// returns the messages gathered so far. A page fetch still running is
+// cancelled and its page reported as NextPage.
+func (c *Client) ListAllMessagesContext(ctx context.Context, opts ListMessagesOptions, onPage PageFunc) (*AllMessagesResult, error) {
+	c = c.WithContext(ctx)
+	opts, err := c.resolveListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("listing all messages", "list", opts.List, "month", opts.Month, "page", opts.Page, "limit", opts.Limit)
+
+	result := &AllMessagesResult{List: opts.List, Month: opts.Month}
+	all := make([]Message, 0)
+	seen := make(map[string]bool)
+
+	for page := opts.Page; page < opts.Page+maxMonthPages; page++ {
+		if ctx.Err() != nil {
+			result.TimedOut, result.NextPage = true, page
+			c.logger.Debug("listing stopped early", "list", opts.List, "month", opts.Month, "next_page", page, "error", ctx.Err())
+			break
+		}
+
+		messages, hasNext, err := c.fetchMessagePage(opts.List, opts.Month, page)
+		if err != nil && ctx.Err() != nil {
+			result.TimedOut, result.NextPage = true, page
+			c.logger.Debug("listing cut off", "list", opts.List, "month", opts.Month, "next_page", page, "error", err)
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		c.storeMessages(messages)
+
+		fresh := make([]Message, 0, len(messages))
+		for _, m := range messages {
+			if !seen[m.ID] {
+				seen[m.ID] = true
+				fresh = append(fresh, m)
+			}
+		}
+
+		// An empty page, or marc repeating the last page, means we're done
+		if len(fresh) == 0 {
+			break
+		}
+
+		fresh = excludeMessages(fresh, opts)
+
+		if opts.Limit > 0 && len(all)+len(fresh) > opts.Limit {
+			fresh = fresh[:opts.Limit-len(all)]
+		}
+		all = append(all, fresh...)
+
+		if onPage != nil {
+			sortMessages(fresh, opts.Order)
+			if err := onPage(page, fresh); err != nil {
+				return nil, err
+			}
+		}
+
+		if opts.Limit > 0 && len(all) >= opts.Limit {
+			break
+		}
+
+		// Once the page size is known, a short page without a next link is
+		// the last one and the trailing empty page need not be fetched
+		if size, ok := c.detectedPageSize(opts.List); ok && !hasNext && len(messages) < size {
+			break
+		}
+	}
+
+	sortMessages(all, opts.Order)
+
+	c.logger.Debug("found messages", "count", len(all))
+	result.Messages = all
+	return result, nil
+}
+
+// resolveListOptions validates opts, resolves list aliases and fills in the
+// default month and page.
+func (c *Client) resolveListOptions(opts ListMessagesOptions) (ListMessagesOptions, error) {
+	opts.List = c.normalizeList(opts.List)
+
 	// Default to current month if not specified
 	if opts.Month == "" {
 		opts.Month = time.Now().Format("200601")
 	}
 	if !validMonth(opts.Month) {
-		return nil, fmt.Errorf("invalid month %q: expected YYYYMM", opts.Month)
+		return opts, fmt.Errorf("%w %q: expected YYYYMM", ErrInvalidMonth, opts.Month)
+	}
+	if !validOrder(opts.Order) {
+		return opts, fmt.Errorf("%w %q: expected %s or %s", ErrInvalidOrder, opts.Order, OrderDesc, OrderAsc)
 	}
 
 	// Default to page 1
 	if opts.Page < 1 {
 		opts.Page = 1
 	}
+	return opts, nil
+}
 
-	c.logger.Debug("listing messages", "list", opts.List, "month", opts.Month, "page", opts.Page, "limit", opts.Limit)
-
-	// Check cache first (only for first page without limit)
-	if opts.Page == 1 && opts.Limit == 0 {
-		if cached, ok := c.cache.GetMessages(opts.List, opts.Month); ok {
-			messages := make([]Message, len(cached))
-			for i, cm := range cached {
-				messages[i] = Message{ID: cm.ID, List: cm.List, Subject: cm.Subject, Author: cm.Author, Date: cm.Date}
-			}
-			return messages, nil
-		}
+// fetchMessagePage fetches and parses a single page of a month listing and
+// reports whether the page links to a following one.
+func (c *Client) fetchMessagePage(list, month string, page int) ([]Message, bool, error) {
+	listing, err := c.fetchListingPage(list, month, page)
+	if err != nil {
+		return nil, false, err
 	}
+	return listing.messages, listing.hasNext, nil
+}
 
-	// Build URL - r=N is page number
-	path := fmt.Sprintf("?l=%s&b=%s&r=%d&w=2", url.QueryEscape(opts.List), url.QueryEscape(opts.Month), opts.Page)
+// listingPage is a parsed month listing page.
+type listingPage struct {
+	// list is the canonical list name, after any redirect.
+	list     string
+	messages []Message
+	hasNext  bool
+	// size is the page length in bytes; unparsed counts the message links
+	// on the page when none of them could be parsed.
+	size     int
+	unparsed int
+}
 
-	raw, err := c.fetchRaw(path)
+// fetchListingPage fetches and parses a single page of a month listing. A
+// page that links to messages without any being parsed is logged as a
+// warning and reported through unparsed.
+func (c *Client) fetchListingPage(list, month string, page int) (*listingPage, error) {
+	raw, list, err := c.fetchList(listMessagesPath(list, month, page), list)
 	if err != nil {
 		return nil, err
 	}
@@ -321,28 +1013,79 @@ func (c *Client) ListMessagesWithOptions(opts ListMessagesOptions) ([]Message, e
 	c.logger.Debug("response length", "bytes", len(raw))
 
 	if strings.Contains(raw, "No such list") {
-		c.logger.Debug("list not found", "list", opts.List)
-		return nil, fmt.Errorf("no such list: %s", opts.List)
+		c.logger.Debug("list not found", "list", list)
+		return nil, fmt.Errorf("no such list: %s", list)
 	}
 
-	messages := parseMessageListFromRaw(raw, opts.List, c.logger)
-	c.logger.Debug("found messages", "count", len(messages))
+	listing := &listingPage{
+		list:     list,
+		messages: parseMessageListFromRaw(raw, list, c.logger),
+		hasNext:  hasNextPage(raw, page),
+		size:     len(raw),
+	}
+	c.logger.Debug("found messages", "count", len(listing.messages), "page", page, "has_next", listing.hasNext)
 
-	// Apply limit if specified
-	if opts.Limit > 0 && len(messages) > opts.Limit {
-		messages = messages[:opts.Limit]
+	if len(listing.messages) == 0 {
+		if n := countMessageLinks(raw); n > 0 {
+			c.logger.Warn("listing page links to messages but none parsed", "list", list, "month", month, "page", page, "links", n, "bytes", len(raw))
+			listing.unparsed = n
+		}
 	}
 
-	// Store in cache
+	if listing.hasNext {
+		c.recordPageSize(list, len(listing.messages))
+	}
+	c.recordListingPage(list, month, page, listing)
+	return listing, nil
+}
+
+// messageHrefRegex matches links to individual messages (m=<digits>) in
+// any attribute quoting or parameter order.
+var messageHrefRegex = regexp.MustCompile(`href=["']?[^"'>]*[?&;]m=\d+`)
+
+// countMessageLinks counts the links to individual messages on a page.
+func countMessageLinks(raw string) int {
+	return len(messageHrefRegex.FindAllStringIndex(raw, -1))
+}
+
+var pageLinkRegex = regexp.MustCompile(`href="\?([^"]*)"`)
+
+// hasNextPage reports whether a listing page links to page+1 of the same
+// listing (marc's "Next" navigation, r=N without a message id).
+func hasNextPage(raw string, page int) bool {
+	want := strconv.Itoa(page + 1)
+	for _, m := range pageLinkRegex.FindAllStringSubmatch(raw, -1) {
+		q, err := url.ParseQuery(html.UnescapeString(m[1]))
+		if err != nil {
+			continue
+		}
+		if q.Get("r") == want && q.Get("m") == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) storeMessages(messages []Message) {
 	cacheMessages := make([]cache.Message, len(messages))
 	for i, m := range messages {
 		cacheMessages[i] = cache.Message{ID: m.ID, List: m.List, Subject: m.Subject, Author: m.Author, Date: m.Date}
 	}
 	c.cache.SetMessages(cacheMessages)
+}
 
-	return messages, nil
+// CachedMonths reports which YYYYMM months have messages in the local cache
+// for a list, without contacting marc.info.
+func (c *Client) CachedMonths(list string) ([]string, error) {
+	list = c.normalizeList(list)
+
+	c.logger.Debug("listing cached months", "list", list)
+	return c.cache.CachedMonths(list)
 }
 
+// ErrInvalidMonth is returned for a month not in YYYYMM format.
+var ErrInvalidMonth = errors.New("invalid month")
+
 func validMonth(month string) bool {
 	if len(month) != 6 {
 		return false
@@ -355,45 +1098,206 @@ func validMonth(month string) bool {
 	return true
 }
 
+// GetMessageOptions controls how GetMessageWithOptions renders a message.
+type GetMessageOptions struct {
+	// PreserveWhitespace returns the body exactly as in marc's <pre> block,
+	// including surrounding blank lines and trailing whitespace. By default
+	// the body is trimmed.
+	PreserveWhitespace bool
+	// IncludeAttachments lists the message's attachments, which takes a
+	// second request for its raw source unless that is cached.
+	IncludeAttachments bool
+}
+
 func (c *Client) GetMessage(list, messageID string) (*MessageContent, error) {
+	return c.GetMessageWithOptions(list, messageID, GetMessageOptions{})
+}
+
+// GetMessageWithOptions is GetMessage with rendering options. Bodies are
+// cached verbatim so either rendering can be served from the cache.
+func (c *Client) GetMessageWithOptions(list, messageID string, opts GetMessageOptions) (*MessageContent, error) {
+	list = c.normalizeList(list)
+
+	messageID, err := NormalizeMessageID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
 	c.logger.Debug("getting message", "list", list, "messageID", messageID)
 
+	msg, err := c.getMessageVerbatim(list, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Fingerprint == "" {
+		msg.Fingerprint = messageFingerprint(msg)
+	}
+	if !opts.PreserveWhitespace {
+		msg.Body = strings.TrimSpace(msg.Body)
+	}
+
+	if opts.IncludeAttachments {
+		msg.Attachments, err = c.MessageAttachments(list, messageID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+func (c *Client) getMessageVerbatim(list, messageID string) (*MessageContent, error) {
 	// Check cache first
 	if cached, ok := c.cache.GetMessageContent(list, messageID); ok {
 		return &MessageContent{
-			Message: Message{ID: cached.ID, List: cached.List, Subject: cached.Subject, Author: cached.Author, Date: cached.Date},
-			Body:    cached.Body,
-			Headers: cached.Headers,
+			Message:     messageFromCache(cached.Message),
+			Body:        cached.Body,
+			Headers:     cached.Headers,
+			Fingerprint: cached.Fingerprint,
 		}, nil
 	}
 
-	path := fmt.Sprintf("?l=%s&m=%s&w=2", url.QueryEscape(list), url.QueryEscape(messageID))
-
-	raw, err := c.fetchRaw(path)
+	raw, list, err := c.fetchList(messagePath(list, messageID), list)
 	if err != nil {
+		if stale, ok := c.staleMessageContent(list, messageID, err); ok {
+			return stale, nil
+		}
 		return nil, err
 	}
 
 	c.logger.Debug("response length", "bytes", len(raw))
 
-	msg, err := parseMessage(raw, list, messageID)
+	msg, err := parseMessageVerbatim(raw, list, messageID)
 	if err != nil {
 		return nil, err
 	}
 
 	c.logger.Debug("parsed message", "subject", msg.Subject, "author", msg.Author)
 
-	// Store in cache
+	c.storeMessageContent(msg)
+	return msg, nil
+}
+
+// storeMessageContent caches msg, filling in its fingerprint first.
+func (c *Client) storeMessageContent(msg *MessageContent) {
+	msg.Fingerprint = messageFingerprint(msg)
 	c.cache.SetMessageContent(&cache.MessageContent{
-		Message: cache.Message{ID: msg.ID, List: msg.List, Subject: msg.Subject, Author: msg.Author, Date: msg.Date},
-		Body:    msg.Body,
-		Headers: msg.Headers,
+		Message:     cache.Message{ID: msg.ID, List: msg.List, Subject: msg.Subject, Author: msg.Author, Date: msg.Date},
+		Body:        msg.Body,
+		Headers:     msg.Headers,
+		Fingerprint: msg.Fingerprint,
 	})
+}
 
-	return msg, nil
+// EvictMessage removes a fetched message from the cache so the next
+// GetMessage parses it afresh. It reports how many entries were removed.
+func (c *Client) EvictMessage(list, messageID string) (int64, error) {
+	list = c.normalizeList(list)
+
+	messageID, err := NormalizeMessageID(messageID)
+	if err != nil {
+		return 0, err
+	}
+	return c.cache.DeleteMessageContent(list, messageID)
+}
+
+// EvictMonth removes a month's cached listing of list. It reports how many
+// entries were removed.
+func (c *Client) EvictMonth(list, month string) (int64, error) {
+	list = c.normalizeList(list)
+
+	if !validMonth(month) {
+		return 0, fmt.Errorf("%w %q: expected YYYYMM", ErrInvalidMonth, month)
+	}
+	return c.cache.DeleteMessages(list, month)
+}
+
+// ErrIndexOutOfRange is returned by GetMessageByIndex for an index outside
+// the month listing.
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+// GetMessageByIndex fetches the index-th (1-based) message of a month's
+// first listing page, newest first as ListMessages orders it. The cached
+// month answers only while it holds page 1 with the pages fetched in order
+// after it, counting just the messages page 1 listed; otherwise page 1 is
+// fetched. It is not served stale, as an expired month cannot tell which
+// of its messages page 1 listed.
+func (c *Client) GetMessageByIndex(list, month string, index int) (*MessageContent, error) {
+	opts, err := c.resolveListOptions(ListMessagesOptions{List: list, Month: month})
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := c.firstListingPage(opts.List, opts.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	if index < 1 || index > len(messages) {
+		return nil, fmt.Errorf("%w: index %d, first page has %d messages", ErrIndexOutOfRange, index, len(messages))
+	}
+
+	return c.GetMessage(opts.List, messages[index-1].ID)
+}
+
+// firstListingPage returns the messages listed on page 1 of a month,
+// newest first.
+func (c *Client) firstListingPage(list, month string) ([]Message, error) {
+	var messages []Message
+	if cached, ok := c.cache.GetMessages(list, month); ok {
+		if first, ok := c.cachedFirstPage(list, month, cached); ok {
+			for _, cm := range first {
+				messages = append(messages, messageFromCache(cm))
+			}
+			sortMessages(messages, OrderDesc)
+			return messages, nil
+		}
+	}
+
+	listing, err := c.fetchListingPage(list, month, 1)
+	if err != nil {
+		return nil, err
+	}
+	c.storeMessages(listing.messages)
+
+	messages = slices.Clone(listing.messages)
+	sortMessages(messages, OrderDesc)
+	return messages, nil
+}
+
+// GetMessageSource returns the raw RFC822 source of a message exactly as
+// marc.info serves it, without entity decoding or header/body splitting.
+func (c *Client) GetMessageSource(list, messageID string) (string, error) {
+	list = c.normalizeList(list)
+
+	messageID, err := NormalizeMessageID(messageID)
+	if err != nil {
+		return "", err
+	}
+
+	c.logger.Debug("getting message source", "list", list, "messageID", messageID)
+
+	// Check cache first
+	if cached, ok := c.cache.GetMessageSource(list, messageID); ok {
+		return cached, nil
+	}
+
+	raw, list, err := c.fetchList(messageSourcePath(list, messageID), list)
+	if err != nil {
+		return "", err
+	}
+
+	c.logger.Debug("response length", "bytes", len(raw))
+
+	// Store in cache
+	c.cache.SetMessageSource(list, messageID, raw)
+
+	return raw, nil
 }
 
 func (c *Client) Search(list, query, searchType string) ([]Message, error) {
+	list = c.normalizeList(list)
+
 	c.logger.Debug("searching", "list", list, "query", query, "type", searchType)
 
 	// searchType: s=subject, a=author, b=body
@@ -401,12 +1305,11 @@ func (c *Client) Search(list, query, searchType string) ([]Message, error) {
 		searchType = "s"
 	}
 
-	path := fmt.Sprintf("?l=%s&s=%s&q=%s&w=2",
-		url.QueryEscape(list),
-		url.QueryEscape(query),
-		url.QueryEscape(searchType))
-
-	doc, err := c.fetch(path)
+	raw, list, err := c.fetchList(searchPath(list, query, searchType), list)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := html.Parse(strings.NewReader(raw))
 	if err != nil {
 		return nil, err
 	}
@@ -416,6 +1319,107 @@ func (c *Client) Search(list, query, searchType string) ([]Message, error) {
 	return messages, nil
 }
 
+// SearchCached runs a full-text search over locally cached messages. list
+// restricts and excludeList drops a list; list wins if both are the same.
+// order sorts the matches by date (OrderDesc or OrderAsc); empty keeps
+// them in relevance order.
+func (c *Client) SearchCached(query, list, excludeList, order string) ([]Message, error) {
+	if !validOrder(order) {
+		return nil, fmt.Errorf("%w %q: expected %s or %s", ErrInvalidOrder, order, OrderDesc, OrderAsc)
+	}
+	list, excludeList = c.normalizeList(list), c.normalizeList(excludeList)
+
+	c.logger.Debug("searching cache", "query", query, "list", list, "exclude", excludeList)
+
+	cached, err := c.cache.SearchMessagesWithOptions(cache.SearchOptions{
+		Query:       query,
+		List:        list,
+		ExcludeList: excludeList,
+		Order:       order,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]Message, len(cached))
+	for i, cm := range cached {
+		messages[i] = messageFromCache(cm)
+	}
+	return messages, nil
+}
+
+// SearchByAuthor searches the local cache for messages whose author
+// contains author, optionally restricted to one list. Newest first.
+func (c *Client) SearchByAuthor(author, list string) ([]Message, error) {
+	list = c.normalizeList(list)
+
+	c.logger.Debug("searching cached authors", "author", author, "list", list)
+
+	cached, err := c.cache.SearchByAuthor(author, list)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]Message, len(cached))
+	for i, cm := range cached {
+		messages[i] = messageFromCache(cm)
+	}
+	return messages, nil
+}
+
+// Facets holds the message counts of a list's cached listings per author
+// and per month (YYYYMM), each limited to the largest buckets.
+type Facets struct {
+	ByAuthor map[string]int `json:"by_author"`
+	ByMonth  map[string]int `json:"by_month"`
+}
+
+// ListFacets counts a list's cached messages by author and by month,
+// without contacting marc.info.
+func (c *Client) ListFacets(list string) (*Facets, error) {
+	list = c.normalizeList(list)
+
+	c.logger.Debug("counting cached facets", "list", list)
+
+	facets, err := c.cache.Facets(list)
+	if err != nil {
+		return nil, err
+	}
+	return &Facets{ByAuthor: facets.ByAuthor, ByMonth: facets.ByMonth}, nil
+}
+
+// CrossPost is one message fetched from several mailing lists.
+type CrossPost struct {
+	MessageID string    `json:"message_id"`
+	Lists     []string  `json:"lists"`
+	Messages  []Message `json:"messages"`
+}
+
+// FindCrossPosts reports cached messages whose Message-ID header appears on
+// more than one list. Only fetched messages are considered, since month
+// listings carry no headers.
+func (c *Client) FindCrossPosts() ([]CrossPost, error) {
+	c.logger.Debug("searching cache for cross-posts")
+
+	cached, err := c.cache.FindCrossPosts()
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]CrossPost, len(cached))
+	for i, cp := range cached {
+		post := CrossPost{MessageID: cp.MessageID, Messages: make([]Message, len(cp.Messages))}
+		for j, cm := range cp.Messages {
+			post.Messages[j] = messageFromCache(cm)
+			if !slices.Contains(post.Lists, cm.List) {
+				post.Lists = append(post.Lists, cm.List)
+			}
+		}
+		posts[i] = post
+	}
+	return posts, nil
+}
+
 var (
 	// Match message links: href="?l=list&m=123456&w=2"
 	messageLinkRegex = regexp.MustCompile(`\?l=([^&]+)&m=(\d+)`)
@@ -433,72 +1437,170 @@ func parseMessageListFromRaw(raw, list string, logger *slog.Logger) []Message {
 	// The messages are in format:
 	//   1. 2026-02-24  [1] <a href="?l=git&m=123">Subject</a> <a href="?l=git&w=2">git</a>  Author
 
-	// Split by lines and process each
-	lines := strings.Split(raw, "\n")
-	logger.Debug("processing lines", "total", len(lines))
+	debug := logger.Enabled(context.Background(), slog.LevelDebug)
+	if debug {
+		logger.Debug("processing lines", "total", strings.Count(raw, "\n")+1)
+	}
 
-	for _, line := range lines {
-		// Skip lines that don't start with a number (message lines start with "  N. ")
-		if !messageLineRegex.MatchString(line) {
-			continue
+	// Walk the lines in place rather than splitting, so non-message lines
+	// cost a single prefix check and no allocation
+	for rest := raw; rest != ""; {
+		line := rest
+		if nl := strings.IndexByte(rest, '\n'); nl >= 0 {
+			line, rest = rest[:nl], rest[nl+1:]
+		} else {
+			rest = ""
 		}
 
-		// Extract date
-		dateMatch := dateRegex.FindString(line)
-		if dateMatch == "" {
+		msg, ok := parseMessageLine(line, list)
+		if !ok {
 			continue
 		}
 
-		// Extract message ID and subject from the message link
-		msgMatch := messageLinkRegex.FindStringSubmatch(line)
-		if len(msgMatch) != 3 {
-			continue
+		if debug {
+			logger.Debug("parsed message", "id", msg.ID, "date", msg.Date, "subject", msg.Subject[:min(30, len(msg.Subject))], "author", msg.Author)
 		}
-		msgID := msgMatch[2]
+		messages = append(messages, msg)
+	}
 
-		// Extract subject from the link text: <a href="...">Subject</a>
-		// Find the content between > and </a> after the message link
-		linkStart := strings.Index(line, "?l="+list+"&m="+msgID)
+	return messages
+}
+
+// parseMessageLine parses a single month-listing line in one left-to-right
+// pass. It is equivalent to matching messageLineRegex for the date,
+// messageLinkRegex for the ID, then slicing out the link text as subject and
+// the text after the last </a> as author.
+func parseMessageLine(line, list string) (Message, bool) {
+	// Message lines start with "  N. YYYY-MM-DD "
+	date, ok := matchMessageLinePrefix(line)
+	if !ok {
+		return Message{}, false
+	}
+
+	// Extract message ID from the first message link
+	linkStart, linkList, msgID := findMessageLink(line)
+	if linkStart == -1 {
+		return Message{}, false
+	}
+	if linkList != list {
+		// The first link points at another list; look for this list's link
+		linkStart = strings.Index(line, "?l="+list+"&m="+msgID)
 		if linkStart == -1 {
-			continue
+			return Message{}, false
+		}
+	}
+
+	// Extract subject from the link text: <a href="...">Subject</a>
+	subjectStart := strings.IndexByte(line[linkStart:], '>')
+	if subjectStart == -1 {
+		return Message{}, false
+	}
+	subjectStart += linkStart + 1
+
+	subjectEnd := strings.Index(line[subjectStart:], "</a>")
+	if subjectEnd == -1 {
+		return Message{}, false
+	}
+
+	subject := decodeHeaderWords(strings.TrimSpace(line[subjectStart : subjectStart+subjectEnd]))
+
+	// Extract author - it's after the last </a> and the list name
+	// Pattern: </a> <a href="?l=git&w=2">git</a>       Author Name
+	author := ""
+	if lastAnchorEnd := strings.LastIndex(line, "</a>"); lastAnchorEnd != -1 && lastAnchorEnd+4 < len(line) {
+		author = decodeHeaderWords(strings.TrimSpace(line[lastAnchorEnd+4:]))
+	}
+
+	return Message{
+		ID:      msgID,
+		Subject: subject,
+		Author:  author,
+		Date:    date,
+		List:    list,
+		Patch:   patchInfo(subject),
+	}, true
+}
+
+// matchMessageLinePrefix is a hand-rolled messageLineRegex: optional
+// whitespace, digits, '.', whitespace, a YYYY-MM-DD date, whitespace.
+func matchMessageLinePrefix(line string) (string, bool) {
+	i := 0
+	for i < len(line) && isRegexSpace(line[i]) {
+		i++
+	}
+
+	digits := i
+	for i < len(line) && isDigit(line[i]) {
+		i++
+	}
+	if i == digits || i >= len(line) || line[i] != '.' {
+		return "", false
+	}
+	i++
+
+	spaces := i
+	for i < len(line) && isRegexSpace(line[i]) {
+		i++
+	}
+	if i == spaces || len(line)-i < len("2006-01-02")+1 {
+		return "", false
+	}
+
+	date := line[i : i+10]
+	for j := 0; j < len(date); j++ {
+		if j == 4 || j == 7 {
+			if date[j] != '-' {
+				return "", false
+			}
+		} else if !isDigit(date[j]) {
+			return "", false
 		}
+	}
 
-		// Find the closing > of the <a> tag
-		subjectStart := strings.Index(line[linkStart:], ">")
-		if subjectStart == -1 {
-			continue
+	if !isRegexSpace(line[i+10]) {
+		return "", false
+	}
+	return date, true
+}
+
+// findMessageLink finds the leftmost match of messageLinkRegex
+// (?l=LIST&m=DIGITS) and returns its offset, list and message ID.
+func findMessageLink(line string) (int, string, string) {
+	for offset := 0; ; {
+		idx := strings.Index(line[offset:], "?l=")
+		if idx == -1 {
+			return -1, "", ""
 		}
-		subjectStart += linkStart + 1
+		start := offset + idx
+		offset = start + 1
 
-		// Find the </a>
-		subjectEnd := strings.Index(line[subjectStart:], "</a>")
-		if subjectEnd == -1 {
+		nameStart := start + len("?l=")
+		amp := strings.IndexByte(line[nameStart:], '&')
+		if amp < 1 {
 			continue
 		}
+		nameEnd := nameStart + amp
 
-		subject := strings.TrimSpace(line[subjectStart : subjectStart+subjectEnd])
-
-		// Extract author - it's after the last </a> and the list name
-		// Pattern: </a> <a href="?l=git&w=2">git</a>       Author Name
-		lastAnchorEnd := strings.LastIndex(line, "</a>")
-		author := ""
-		if lastAnchorEnd != -1 && lastAnchorEnd+4 < len(line) {
-			author = strings.TrimSpace(line[lastAnchorEnd+4:])
+		idStart := nameEnd + len("&m=")
+		if !strings.HasPrefix(line[nameEnd:], "&m=") || idStart >= len(line) || !isDigit(line[idStart]) {
+			continue
 		}
-
-		msg := Message{
-			ID:      msgID,
-			Subject: subject,
-			Author:  author,
-			Date:    dateMatch,
-			List:    list,
+		idEnd := idStart
+		for idEnd < len(line) && isDigit(line[idEnd]) {
+			idEnd++
 		}
 
-		logger.Debug("parsed message", "id", msgID, "date", dateMatch, "subject", subject[:min(30, len(subject))], "author", author)
-		messages = append(messages, msg)
+		return start, line[nameStart:nameEnd], line[idStart:idEnd]
 	}
+}
 
-	return messages
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// isRegexSpace matches RE2's \s class.
+func isRegexSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\f' || b == '\r'
 }
 
 // Keep old function for backward compatibility with Search
@@ -510,14 +1612,16 @@ func parseMessageList(doc *html.Node, list string) []Message {
 		if n.Type == html.ElementNode && n.Data == "a" {
 			href := getAttr(n, "href")
 			if matches := messageLinkRegex.FindStringSubmatch(href); len(matches) == 3 {
-				subject := strings.TrimSpace(extractText(n))
+				subject := decodeHeaderWords(strings.TrimSpace(extractText(n)))
 				if subject != "" {
 					msg := Message{
 						ID:      matches[2],
 						Subject: subject,
 						List:    list,
+						Patch:   patchInfo(subject),
 					}
 					msg.Date, msg.Author = extractMessageMetaSimple(n)
+					msg.Author = decodeHeaderWords(msg.Author)
 					messages = append(messages, msg)
 				}
 			}
@@ -553,6 +1657,16 @@ func extractMessageMetaSimple(n *html.Node) (date, author string) {
 }
 
 func parseMessage(raw, list, messageID string) (*MessageContent, error) {
+	msg, err := parseMessageVerbatim(raw, list, messageID)
+	if err != nil {
+		return nil, err
+	}
+	msg.Body = strings.TrimSpace(msg.Body)
+	return msg, nil
+}
+
+// parseMessageVerbatim is parseMessage without trimming the body.
+func parseMessageVerbatim(raw, list, messageID string) (*MessageContent, error) {
 	doc, err := html.Parse(strings.NewReader(raw))
 	if err != nil {
 		return nil, err
@@ -599,6 +1713,7 @@ func parseMessage(raw, list, messageID string) (*MessageContent, error) {
 	// Parse headers and body
 	inHeaders := true
 	var bodyLines []string
+	var lastKey string
 
 	for _, line := range lines {
 		if inHeaders {
@@ -606,30 +1721,72 @@ func parseMessage(raw, list, messageID string) (*MessageContent, error) {
 				inHeaders = false
 				continue
 			}
-			if idx := strings.Index(line, ":"); idx > 0 {
-				key := strings.TrimSpace(line[:idx])
-				value := strings.TrimSpace(line[idx+1:])
-				msg.Headers[key] = value
-
-				switch strings.ToLower(key) {
-				case "subject":
-					msg.Subject = value
-				case "from":
-					msg.Author = value
-				case "date":
-					msg.Date = value
+			// A folded line continues the previous header, even when it
+			// contains colons itself (e.g. a time in a Received header)
+			if lastKey != "" && (line[0] == ' ' || line[0] == '\t') {
+				if more := strings.TrimSpace(line); more != "" {
+					setHeader(msg, lastKey, msg.Headers[lastKey]+" "+more)
 				}
+				continue
+			}
+			key, value, ok := parseHeaderLine(line)
+			if !ok {
+				// Navigation and other non-header lines end any folding
+				lastKey = ""
+				continue
 			}
+			setHeader(msg, key, value)
+			lastKey = key
 		} else {
 			bodyLines = append(bodyLines, line)
 		}
 	}
 
-	msg.Body = strings.TrimSpace(strings.Join(bodyLines, "\n"))
+	msg.Body = decodeBody(strings.Join(bodyLines, "\n"), msg.Headers, metaCharset(doc))
 
 	return msg, nil
 }
 
+// parseHeaderLine splits a "Key: value" line at its first colon, so colons
+// in the value (times, "Re: [PATCH]: fix") are kept. The key must be a
+// valid field name: printable ASCII without spaces, though whitespace
+// before the colon is tolerated.
+func parseHeaderLine(line string) (key, value string, ok bool) {
+	key, value, found := strings.Cut(line, ":")
+	key = strings.TrimRight(key, " \t")
+	if !found || !validHeaderKey(key) {
+		return "", "", false
+	}
+	return key, strings.TrimSpace(value), true
+}
+
+func validHeaderKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] <= ' ' || key[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// setHeader records a header and the message fields derived from it.
+func setHeader(msg *MessageContent, key, value string) {
+	msg.Headers[key] = value
+
+	switch strings.ToLower(key) {
+	case "subject":
+		msg.Subject = decodeHeaderWords(value)
+		msg.Patch = patchInfo(msg.Subject)
+	case "from":
+		msg.Author = decodeHeaderWords(value)
+	case "date":
+		msg.Date = value
+	}
+}
+
 func extractText(n *html.Node) string {
 	var text strings.Builder
 	var walk func(*html.Node)
@@ -654,14 +1811,24 @@ func getAttr(n *html.Node, key string) string {
 	return ""
 }
 
+// extractListName returns the l= query parameter of a relative or absolute
+// marc.info href. Links to other hosts yield "".
 func extractListName(href string) string {
 	u, err := url.Parse(href)
 	if err != nil {
 		return ""
 	}
+	if u.Host != "" && !isMarcHost(u.Hostname()) {
+		return ""
+	}
 	return u.Query().Get("l")
 }
 
+func isMarcHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "marc.info" || strings.HasSuffix(host, ".marc.info")
+}
+
 func extractMessageID(href string) string {
 	u, err := url.Parse(href)
 	if err != nil {
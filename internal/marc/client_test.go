@@ -1,14 +1,58 @@
 package marc
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/andr1an/marc-mcp/internal/cache"
+	"github.com/andr1an/marc-mcp/internal/logctx"
 	"golang.org/x/net/html"
 )
 
+// newTestClient returns a Client that talks to an httptest server running
+// handler and caches into a temporary database.
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	c, err := cache.New(cache.Options{
+		DBPath: filepath.Join(t.TempDir(), "cache.db"),
+		TTL:    time.Hour,
+		Logger: logger,
+	})
+	if err != nil {
+		t.Fatalf("failed to create cache: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	return &Client{
+		baseURL:       srv.URL + "/",
+		conn:          newConnState(srv.Client(), 0),
+		pageSizes:     newPageSizeCache(),
+		monthPages:    newMonthPages(),
+		listRedirects: newListRedirects(),
+		cache:         c,
+		logger:        logger,
+	}
+}
+
 func TestGetTimeout(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -39,6 +83,74 @@ func TestGetTimeout(t *testing.T) {
 	}
 }
 
+func TestParseListTTL(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	got := parseListTTL("linux-kernel=10m, git=1h,bad,=5m,neg=-1m,junk=abc", logger)
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 overrides, got %v", got)
+	}
+	if got["linux-kernel"] != 10*time.Minute {
+		t.Errorf("linux-kernel TTL = %v, want 10m", got["linux-kernel"])
+	}
+	if got["git"] != time.Hour {
+		t.Errorf("git TTL = %v, want 1h", got["git"])
+	}
+}
+
+func TestParseListAliases(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	got := parseListAliases("lkml=linux-kernel, git-list = git,bad,=x,empty=,old=lkml", logger)
+
+	want := map[string]string{"lkml": "linux-kernel", "git-list": "git"}
+	if len(got) != len(want) || got["lkml"] != want["lkml"] || got["git-list"] != want["git-list"] {
+		t.Errorf("parseListAliases() = %v, want %v", got, want)
+	}
+}
+
+func TestListAliases(t *testing.T) {
+	var lists []string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lists = append(lists, r.URL.Query().Get("l"))
+		_, _ = io.WriteString(w, monthPage("linux-kernel", Message{ID: "1", Date: "2024-01-02", Subject: "s", Author: "a"}))
+	}))
+	c.listAliases = map[string]string{"lkml": "linux-kernel"}
+
+	messages, err := c.ListMessages("lkml", "202401")
+	if err != nil {
+		t.Fatalf("ListMessages failed: %v", err)
+	}
+	if len(messages) != 1 || messages[0].List != "linux-kernel" {
+		t.Errorf("messages = %+v, want one linux-kernel message", messages)
+	}
+
+	// The canonical name shares the cache entry
+	if _, err := c.ListMessages("linux-kernel", "202401"); err != nil {
+		t.Fatalf("ListMessages failed: %v", err)
+	}
+	if len(lists) != 1 || lists[0] != "linux-kernel" {
+		t.Errorf("requested lists = %v, want [linux-kernel]", lists)
+	}
+
+	dry := c.PlanSearch("lkml", "oops", "")
+	if dry.List != "linux-kernel" || !strings.Contains(dry.URL, "l=linux-kernel") {
+		t.Errorf("PlanSearch = %+v, want linux-kernel", dry)
+	}
+}
+
+func TestParsePragmas(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	got := parsePragmas("cache_size=-20000, MMAP_SIZE = 268435456,bad,=1,temp_store=", logger)
+
+	want := map[string]string{"cache_size": "-20000", "mmap_size": "268435456"}
+	if len(got) != len(want) || got["cache_size"] != want["cache_size"] || got["mmap_size"] != want["mmap_size"] {
+		t.Errorf("parsePragmas() = %v, want %v", got, want)
+	}
+}
+
 func TestValidMonth(t *testing.T) {
 	tests := []struct {
 		month string
@@ -73,6 +185,10 @@ func TestExtractListName(t *testing.T) {
 		{"", ""},
 		{"invalid", ""},
 		{"?m=123", ""},
+		{"/?l=git&w=2", "git"},
+		{"https://marc.info/?l=git", "git"},
+		{"http://MARC.info/?l=openssh-unix-dev&w=2", "openssh-unix-dev"},
+		{"https://example.com/?l=git", ""},
 	}
 
 	for _, tt := range tests {
@@ -239,6 +355,27 @@ func TestParseMessageListFromRaw(t *testing.T) {
 	}
 }
 
+func TestParseMessageListFromRaw_EdgeCases(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	testHTML := `<pre>
+   1. 2026-02-24  [1] <a href="?l=other&m=5&w=2">x</a> <a href="?l=git&m=6&w=2">Cross-posted</a> <a href="?l=git&w=2">git</a>  Dana
+   2. 2026-02-23  [1] no link on this line
+   3. 2026-02-22  [1] <a href="?l=git&m=7&w=2">Unterminated subject
+   4. 2026-02-21  [1] <a href="?l=git&m=8&w=2">Last line without newline</a>  Erin`
+
+	messages := parseMessageListFromRaw(testHTML, "git", logger)
+
+	// Line 1's first link names another list and this list has no link
+	// with the same ID, lines 2 and 3 are incomplete
+	if len(messages) != 1 {
+		t.Fatalf("expected 1 message, got %d: %+v", len(messages), messages)
+	}
+	if messages[0].ID != "8" || messages[0].Subject != "Last line without newline" || messages[0].Author != "Erin" {
+		t.Errorf("message 0 = %+v", messages[0])
+	}
+}
+
 func TestParseMessageListFromRaw_Empty(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
 
@@ -320,6 +457,185 @@ Date: Thu, 15 Feb 2026 10:30:00 +0000
 	}
 }
 
+func TestParseMessageHeaderColons(t *testing.T) {
+	page := messageHTML([]string{
+		"[prev in list] [next in list]",
+		"Subject: Re: [PATCH]: fix parser: handle colons",
+		"From: Alice <alice@example.com>",
+		"Date: Thu, 15 Feb 2026 10:30:00 +0000",
+		"Received: from mail.example.com (mail.example.com [192.0.2.1]:25)",
+		"\tby mx.example.org with ESMTPS id abc123; Thu, 15 Feb 2026 10:30:05 +0000",
+		"X-Time:10:30:00",
+		"Odd Key: not a header",
+		"List-Id: <git.vger.kernel.org>",
+	}, "Body: with a colon")
+
+	msg, err := parseMessage(page, "git", "1")
+	if err != nil {
+		t.Fatalf("parseMessage failed: %v", err)
+	}
+
+	want := map[string]string{
+		"Subject":  "Re: [PATCH]: fix parser: handle colons",
+		"From":     "Alice <alice@example.com>",
+		"Date":     "Thu, 15 Feb 2026 10:30:00 +0000",
+		"Received": "from mail.example.com (mail.example.com [192.0.2.1]:25) by mx.example.org with ESMTPS id abc123; Thu, 15 Feb 2026 10:30:05 +0000",
+		"X-Time":   "10:30:00",
+		"List-Id":  "<git.vger.kernel.org>",
+	}
+	if len(msg.Headers) != len(want) {
+		t.Errorf("headers = %q, want %q", msg.Headers, want)
+	}
+	for key, value := range want {
+		if msg.Headers[key] != value {
+			t.Errorf("Headers[%s] = %q, want %q", key, msg.Headers[key], value)
+		}
+	}
+
+	if msg.Subject != want["Subject"] {
+		t.Errorf("Subject = %q, want %q", msg.Subject, want["Subject"])
+	}
+	if msg.Date != want["Date"] {
+		t.Errorf("Date = %q, want %q", msg.Date, want["Date"])
+	}
+	if msg.Body != "Body: with a colon" {
+		t.Errorf("Body = %q, want the first body line intact", msg.Body)
+	}
+}
+
+func TestParseHeaderLine(t *testing.T) {
+	tests := []struct {
+		line       string
+		key, value string
+		ok         bool
+	}{
+		{"Subject: Re: [PATCH]: fix", "Subject", "Re: [PATCH]: fix", true},
+		{"Date: Thu, 15 Feb 2026 10:30:00 +0000", "Date", "Thu, 15 Feb 2026 10:30:00 +0000", true},
+		{"X-Time:10:30", "X-Time", "10:30", true},
+		{"Subject : spaced", "Subject", "spaced", true},
+		{"Empty:", "Empty", "", true},
+		{": no key", "", "", false},
+		{"two words: value", "", "", false},
+		{"no colon at all", "", "", false},
+		{"Bad\u00e9Key: value", "", "", false},
+	}
+	for _, tt := range tests {
+		key, value, ok := parseHeaderLine(tt.line)
+		if key != tt.key || value != tt.value || ok != tt.ok {
+			t.Errorf("parseHeaderLine(%q) = %q, %q, %v; want %q, %q, %v", tt.line, key, value, ok, tt.key, tt.value, tt.ok)
+		}
+	}
+}
+
+func TestGetMessagePreserveWhitespace(t *testing.T) {
+	const body = "\n\n  indented first line\nlast line  \n\n"
+	page := messageHTML([]string{"From: Test", "Subject: Spacing"}, body)
+
+	var requests int
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, page)
+	}))
+
+	verbatim, err := c.GetMessageWithOptions("test", "1", GetMessageOptions{PreserveWhitespace: true})
+	if err != nil {
+		t.Fatalf("GetMessageWithOptions failed: %v", err)
+	}
+	// messageHTML closes the <pre> on its own line
+	if want := body + "\n"; verbatim.Body != want {
+		t.Errorf("preserved body = %q, want %q", verbatim.Body, want)
+	}
+
+	trimmed, err := c.GetMessage("test", "1")
+	if err != nil {
+		t.Fatalf("GetMessage failed: %v", err)
+	}
+	if want := "indented first line\nlast line"; trimmed.Body != want {
+		t.Errorf("default body = %q, want %q", trimmed.Body, want)
+	}
+
+	cached, err := c.GetMessageWithOptions("test", "1", GetMessageOptions{PreserveWhitespace: true})
+	if err != nil {
+		t.Fatalf("GetMessageWithOptions failed: %v", err)
+	}
+	if cached.Body != verbatim.Body {
+		t.Errorf("cached preserved body = %q, want %q", cached.Body, verbatim.Body)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestParseMailingListsMixedHrefs(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	index := `<html><body><dl>
+<dt><b><img alt="Group: " src="group.gif"> Development</b></dt>
+<dd><a href="?l=git&w=2">git</a></dd>
+<dd><a href="https://marc.info/?l=mercurial&w=2">mercurial</a></dd>
+<dt><b><img alt="Group: " src="group.gif"> Linux</b></dt>
+<dd><a href="/?l=linux-kernel&w=2">linux-kernel</a></dd>
+<dd><a href="http://marc.info/?l=linux-mm">linux-mm</a></dd>
+<dd><a href="https://example.com/?l=not-a-list">elsewhere</a></dd>
+<dd><a href="/about.html">about</a></dd>
+</dl></body></html>`
+
+	doc, err := html.Parse(strings.NewReader(index))
+	if err != nil {
+		t.Fatalf("failed to parse HTML: %v", err)
+	}
+
+	lists := parseMailingLists(doc, logger)
+
+	expected := []MailingList{
+		{Name: "git", Category: "Development"},
+		{Name: "mercurial", Category: "Development"},
+		{Name: "linux-kernel", Category: "Linux"},
+		{Name: "linux-mm", Category: "Linux"},
+	}
+	if len(lists) != len(expected) {
+		t.Fatalf("expected %d lists, got %d: %+v", len(expected), len(lists), lists)
+	}
+	for i, want := range expected {
+		if lists[i] != want {
+			t.Errorf("list %d = %+v, want %+v", i, lists[i], want)
+		}
+	}
+}
+
+func TestParseMailingListDetails(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	index := `<html><body><dl>
+<dt><b><img alt="Group: " src="group.gif"> Development</b></dt>
+<dd><a href="?l=git&w=2">git</a> - Git SCM <i>discussion</i> <a href="mailto:git@vger.kernel.org?subject=hi">post</a></dd>
+<dd><a href="?l=mercurial&w=2">mercurial</a></dd>
+<dd><a href="?l=svn&w=2">svn</a>: Subversion users<br><a href="?l=svn-dev&w=2">svn-dev</a> <a href="mailto:dev@subversion.apache.org">dev@subversion.apache.org</a></dd>
+</dl></body></html>`
+
+	doc, err := html.Parse(strings.NewReader(index))
+	if err != nil {
+		t.Fatalf("failed to parse HTML: %v", err)
+	}
+
+	lists := parseMailingLists(doc, logger)
+
+	expected := []MailingList{
+		{Name: "git", Category: "Development", Description: "Git SCM discussion", PostAddress: "git@vger.kernel.org"},
+		{Name: "mercurial", Category: "Development"},
+		{Name: "svn", Category: "Development", Description: "Subversion users"},
+		{Name: "svn-dev", Category: "Development", PostAddress: "dev@subversion.apache.org"},
+	}
+	if len(lists) != len(expected) {
+		t.Fatalf("expected %d lists, got %d: %+v", len(expected), len(lists), lists)
+	}
+	for i, want := range expected {
+		if lists[i] != want {
+			t.Errorf("list %d = %+v, want %+v", i, lists[i], want)
+		}
+	}
+}
+
 func TestExtractCategory(t *testing.T) {
 	tests := []struct {
 		name string
@@ -433,6 +749,8 @@ func TestMessageLineRegex(t *testing.T) {
 		{" 100. 2025-12-31  [1] message", true},
 		{"1. 2026-02-24 message", true},    // Regex allows zero leading spaces
 		{"   1 2026-02-24 message", false}, // Missing period
+		{"   1. 2026-02-24message", false}, // Missing space after date
+		{"\t7.\t2026-02-24\tmessage", true},
 		{"not a message line", false},
 		{"", false},
 	}
@@ -443,6 +761,11 @@ func TestMessageLineRegex(t *testing.T) {
 			if got != tt.match {
 				t.Errorf("messageLineRegex.MatchString(%q) = %v, want %v", tt.input, got, tt.match)
 			}
+
+			// The hand-rolled matcher used by the parser must agree
+			if _, ok := matchMessageLinePrefix(tt.input); ok != tt.match {
+				t.Errorf("matchMessageLinePrefix(%q) = %v, want %v", tt.input, ok, tt.match)
+			}
 		})
 	}
 }
@@ -465,3 +788,652 @@ func TestListMessagesOptions_Defaults(t *testing.T) {
 		// This is handled in ListMessagesWithOptions
 	}
 }
+
+func TestGetMessageSource(t *testing.T) {
+	const source = "From: Alice &lt;alice@example.com&gt;\r\nSubject: Test\r\n\r\nBody &amp; more\r\n"
+
+	var hits int
+	var gotQuery string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		gotQuery = r.URL.RawQuery
+		_, _ = io.WriteString(w, source)
+	}))
+
+	got, err := client.GetMessageSource("git", "123456")
+	if err != nil {
+		t.Fatalf("GetMessageSource failed: %v", err)
+	}
+	if got != source {
+		t.Errorf("source = %q, want %q", got, source)
+	}
+	if gotQuery != "l=git&m=123456&q=raw" {
+		t.Errorf("query = %q, want %q", gotQuery, "l=git&m=123456&q=raw")
+	}
+
+	// Second call is served from the cache
+	if _, err := client.GetMessageSource("git", "123456"); err != nil {
+		t.Fatalf("cached GetMessageSource failed: %v", err)
+	}
+	if hits != 1 {
+		t.Errorf("expected 1 upstream request, got %d", hits)
+	}
+}
+
+// monthPage renders messages the way marc.info lays out a month listing.
+func monthPage(list string, messages ...Message) string {
+	var b strings.Builder
+	b.WriteString("<html><body><pre>\n")
+	for i, m := range messages {
+		fmt.Fprintf(&b, "  %d. %s  [1] <a href=\"?l=%s&m=%s&w=2\">%s</a> <a href=\"?l=%s&w=2\">%s</a>  %s\n",
+			i+1, m.Date, list, m.ID, m.Subject, list, list, m.Author)
+	}
+	b.WriteString("</pre></body></html>")
+	return b.String()
+}
+
+func TestListMessagesPageEmptyVsUnparsed(t *testing.T) {
+	pages := map[string]string{
+		"empty":    "<html><body><pre>\n</pre></body></html>",
+		"full":     monthPage("full", Message{ID: "1", Date: "2026-02-01", Subject: "Hello", Author: "A"}),
+		"reworked": `<html><body><ul><li><a href="?l=reworked&amp;m=123&amp;w=2">Hello</a> by A</li></ul></body></html>`,
+		"missing":  "<html><body>No such list missing</body></html>",
+	}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, pages[r.URL.Query().Get("l")])
+	}))
+
+	t.Run("empty month", func(t *testing.T) {
+		page, err := c.ListMessagesPage(ListMessagesOptions{List: "empty", Month: "202602"})
+		if err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		if len(page.Messages) != 0 || !page.Empty || page.Diagnostic != "" {
+			t.Errorf("expected an empty month without diagnostic, got %+v", page)
+		}
+		if page.ParsedFrom != len(pages["empty"]) {
+			t.Errorf("ParsedFrom = %d, want %d", page.ParsedFrom, len(pages["empty"]))
+		}
+	})
+
+	t.Run("messages", func(t *testing.T) {
+		page, err := c.ListMessagesPage(ListMessagesOptions{List: "full", Month: "202602"})
+		if err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		if len(page.Messages) != 1 || page.Empty || page.Diagnostic != "" || page.ParsedFrom != len(pages["full"]) {
+			t.Errorf("unexpected page: %+v", page)
+		}
+
+		// Served from the cache, so nothing was parsed
+		page, err = c.ListMessagesPage(ListMessagesOptions{List: "full", Month: "202602"})
+		if err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		if len(page.Messages) != 1 || page.ParsedFrom != 0 {
+			t.Errorf("expected a cached page, got %+v", page)
+		}
+	})
+
+	t.Run("unparsable page", func(t *testing.T) {
+		page, err := c.ListMessagesPage(ListMessagesOptions{List: "reworked", Month: "202602"})
+		if err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		if len(page.Messages) != 0 || page.Empty {
+			t.Errorf("expected no messages and not empty, got %+v", page)
+		}
+		if !strings.Contains(page.Diagnostic, "with 1 message links") {
+			t.Errorf("expected a diagnostic, got %q", page.Diagnostic)
+		}
+	})
+
+	t.Run("no such list", func(t *testing.T) {
+		if _, err := c.ListMessagesPage(ListMessagesOptions{List: "missing", Month: "202602"}); err == nil {
+			t.Error("expected an error for a missing list")
+		}
+	})
+}
+
+func TestListMessagesPageNextPage(t *testing.T) {
+	first := strings.Replace(
+		monthPage("git", Message{ID: "2", Date: "2026-02-02", Subject: "Second", Author: "B"}),
+		"</pre>", "</pre>[<a href=\"?l=git&amp;r=2&amp;b=202602&amp;w=2\">Next</a>]", 1)
+	last := monthPage("git", Message{ID: "1", Date: "2026-02-01", Subject: "First", Author: "A"})
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("r") == "1" {
+			_, _ = io.WriteString(w, first)
+			return
+		}
+		_, _ = io.WriteString(w, last)
+	}))
+
+	page, err := client.ListMessagesPage(ListMessagesOptions{List: "git", Month: "202602", Limit: 10})
+	if err != nil {
+		t.Fatalf("ListMessagesPage failed: %v", err)
+	}
+	if page.NextPage != 2 || page.List != "git" || page.Month != "202602" {
+		t.Errorf("unexpected first page: %+v", page)
+	}
+
+	page, err = client.ListMessagesPage(ListMessagesOptions{List: "git", Month: "202602", Page: 2})
+	if err != nil {
+		t.Fatalf("ListMessagesPage failed: %v", err)
+	}
+	if page.NextPage != 0 {
+		t.Errorf("expected last page, got next page %d", page.NextPage)
+	}
+	if len(page.Messages) != 1 || page.Messages[0].ID != "1" {
+		t.Errorf("unexpected messages on last page: %+v", page.Messages)
+	}
+}
+
+func TestListMessagesPageCacheHit(t *testing.T) {
+	first := strings.Replace(
+		monthPage("git", Message{ID: "2", Date: "2026-02-02", Subject: "Second", Author: "B"}),
+		"</pre>", "</pre>[<a href=\"?l=git&amp;r=2&amp;b=202602&amp;w=2\">Next</a>]", 1)
+	last := monthPage("git", Message{ID: "1", Date: "2026-02-01", Subject: "First", Author: "A"})
+
+	var requests int
+	newClient := func() *Client {
+		return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if r.URL.Query().Get("r") == "1" {
+				_, _ = io.WriteString(w, first)
+				return
+			}
+			_, _ = io.WriteString(w, last)
+		}))
+	}
+	firstPage := ListMessagesOptions{List: "git", Month: "202602"}
+	secondPage := ListMessagesOptions{List: "git", Month: "202602", Page: 2}
+
+	t.Run("contiguous pages", func(t *testing.T) {
+		client := newClient()
+		if _, err := client.ListMessagesPage(firstPage); err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+
+		// Page 1 alone is served from the cache, resuming at page 2
+		requests = 0
+		page, err := client.ListMessagesPage(firstPage)
+		if err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		if requests != 0 || len(page.Messages) != 1 || page.NextPage != 2 {
+			t.Errorf("expected cached page 1 with next page 2, got %+v after %d requests", page, requests)
+		}
+
+		// Pages 1 and 2 in order are the whole month
+		if _, err := client.ListMessagesPage(secondPage); err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		requests = 0
+		page, err = client.ListMessagesPage(firstPage)
+		if err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		if requests != 0 || len(page.Messages) != 2 || page.NextPage != 0 {
+			t.Errorf("expected the cached month with no next page, got %+v after %d requests", page, requests)
+		}
+	})
+
+	t.Run("page 2 alone", func(t *testing.T) {
+		client := newClient()
+		if _, err := client.ListMessagesPage(secondPage); err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+
+		// Only page 2 is cached, so page 1 is fetched live
+		requests = 0
+		page, err := client.ListMessagesPage(firstPage)
+		if err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		if requests != 1 || len(page.Messages) != 1 || page.Messages[0].ID != "2" || page.NextPage != 2 {
+			t.Errorf("expected page 1 fetched live with next page 2, got %+v after %d requests", page, requests)
+		}
+
+		// The cache still holds page 2 fetched out of order beside page 1
+		requests = 0
+		if _, err := client.ListMessagesPage(firstPage); err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		if requests != 1 {
+			t.Errorf("expected page 1 fetched live, got %d requests", requests)
+		}
+	})
+}
+
+func TestGetMessageByIndex(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		if id := q.Get("m"); id != "" {
+			_, _ = io.WriteString(w, messageHTML([]string{"Subject: Message " + id, "From: A"}, "body"))
+			return
+		}
+		_, _ = io.WriteString(w, monthPage("git",
+			Message{ID: "30", Date: "2026-02-03", Subject: "Message 30", Author: "C"},
+			Message{ID: "20", Date: "2026-02-02", Subject: "Message 20", Author: "B"},
+			Message{ID: "10", Date: "2026-02-01", Subject: "Message 10", Author: "A"},
+		))
+	}))
+
+	tests := []struct {
+		name    string
+		index   int
+		wantID  string
+		wantErr bool
+	}{
+		{"first", 1, "30", false},
+		{"last", 3, "10", false},
+		{"zero", 0, "", true},
+		{"past end", 4, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := client.GetMessageByIndex("git", "202602", tt.index)
+			if tt.wantErr {
+				if !errors.Is(err, ErrIndexOutOfRange) {
+					t.Fatalf("expected ErrIndexOutOfRange, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetMessageByIndex failed: %v", err)
+			}
+			if msg.ID != tt.wantID || msg.Subject != "Message "+tt.wantID {
+				t.Errorf("got message %s (%q), want %s", msg.ID, msg.Subject, tt.wantID)
+			}
+		})
+	}
+
+	t.Run("reuses cached listing and content", func(t *testing.T) {
+		before := requests
+		if _, err := client.GetMessageByIndex("git", "202602", 1); err != nil {
+			t.Fatalf("GetMessageByIndex failed: %v", err)
+		}
+		if requests != before {
+			t.Errorf("expected cache hits, made %d requests", requests-before)
+		}
+	})
+}
+
+func TestGetMessageByIndexCountsFirstPage(t *testing.T) {
+	first := strings.Replace(
+		monthPage("git",
+			Message{ID: "30", Date: "2026-02-03", Subject: "Message 30", Author: "C"},
+			Message{ID: "20", Date: "2026-02-02", Subject: "Message 20", Author: "B"},
+		),
+		"</pre>", "</pre>[<a href=\"?l=git&amp;r=2&amp;b=202602&amp;w=2\">Next</a>]", 1)
+	last := monthPage("git", Message{ID: "10", Date: "2026-02-01", Subject: "Message 10", Author: "A"})
+
+	var requests int
+	newClient := func() *Client {
+		return newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			q := r.URL.Query()
+			switch {
+			case q.Get("m") != "":
+				_, _ = io.WriteString(w, messageHTML([]string{"Subject: Message " + q.Get("m"), "From: A"}, "body"))
+			case q.Get("r") == "1":
+				_, _ = io.WriteString(w, first)
+			default:
+				_, _ = io.WriteString(w, last)
+			}
+		}))
+	}
+
+	t.Run("cached month", func(t *testing.T) {
+		client := newClient()
+		if _, err := client.ListAllMessages(ListMessagesOptions{List: "git", Month: "202602"}, nil); err != nil {
+			t.Fatalf("ListAllMessages failed: %v", err)
+		}
+
+		requests = 0
+		if _, err := client.GetMessageByIndex("git", "202602", 3); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("expected index 3 past page 1 to be out of range, got %v", err)
+		}
+		msg, err := client.GetMessageByIndex("git", "202602", 2)
+		if err != nil {
+			t.Fatalf("GetMessageByIndex failed: %v", err)
+		}
+		if msg.ID != "20" {
+			t.Errorf("got message %s, want 20", msg.ID)
+		}
+		// Only the message body is fetched; the listing is cached
+		if requests != 1 {
+			t.Errorf("expected 1 request, got %d", requests)
+		}
+	})
+
+	t.Run("live page", func(t *testing.T) {
+		client := newClient()
+		// Page 2 alone leaves no cached page 1
+		if _, err := client.ListMessagesPage(ListMessagesOptions{List: "git", Month: "202602", Page: 2}); err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+
+		requests = 0
+		if _, err := client.GetMessageByIndex("git", "202602", 3); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("expected index 3 past page 1 to be out of range, got %v", err)
+		}
+		if requests != 1 {
+			t.Errorf("expected page 1 fetched live, got %d requests", requests)
+		}
+		msg, err := client.GetMessageByIndex("git", "202602", 1)
+		if err != nil {
+			t.Fatalf("GetMessageByIndex failed: %v", err)
+		}
+		if msg.ID != "30" {
+			t.Errorf("got message %s, want 30", msg.ID)
+		}
+	})
+}
+
+func TestListCategory(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = io.WriteString(w, `<html><body><dl>
+<dt><b><img alt="Group: ">Development</b></dt><dd><a href="?l=git&w=2">git</a></dd>
+<dt><b><img alt="Group: ">Linux</b></dt><dd><a href="?l=linux-kernel&w=2">linux-kernel</a></dd>
+</dl></body></html>`)
+	}))
+
+	t.Run("miss populates the catalog", func(t *testing.T) {
+		category, found, err := client.ListCategory("linux-kernel")
+		if err != nil {
+			t.Fatalf("ListCategory failed: %v", err)
+		}
+		if !found || category != "Linux" {
+			t.Errorf("ListCategory(linux-kernel) = %q, %v", category, found)
+		}
+		if requests != 1 {
+			t.Errorf("expected 1 request, got %d", requests)
+		}
+	})
+
+	t.Run("hit uses the cache", func(t *testing.T) {
+		category, found, err := client.ListCategory("git")
+		if err != nil {
+			t.Fatalf("ListCategory failed: %v", err)
+		}
+		if !found || category != "Development" {
+			t.Errorf("ListCategory(git) = %q, %v", category, found)
+		}
+		if requests != 1 {
+			t.Errorf("expected no new requests, got %d total", requests)
+		}
+	})
+
+	t.Run("unknown list", func(t *testing.T) {
+		category, found, err := client.ListCategory("no-such-list")
+		if err != nil {
+			t.Fatalf("ListCategory failed: %v", err)
+		}
+		if found || category != "" {
+			t.Errorf("ListCategory(no-such-list) = %q, %v, want not found", category, found)
+		}
+	})
+}
+
+func TestListAllMessages(t *testing.T) {
+	pages := map[string]string{
+		"1": monthPage("git",
+			Message{ID: "3", Date: "2026-02-03", Subject: "Third", Author: "C"},
+			Message{ID: "2", Date: "2026-02-02", Subject: "Second", Author: "B"},
+		),
+		"2": monthPage("git",
+			Message{ID: "1", Date: "2026-02-01", Subject: "First", Author: "A"},
+		),
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, pages[r.URL.Query().Get("r")])
+	}))
+
+	t.Run("accumulates every page", func(t *testing.T) {
+		var seenPages []int
+		messages, err := client.ListAllMessages(ListMessagesOptions{List: "git", Month: "202602"}, func(page int, msgs []Message) error {
+			seenPages = append(seenPages, page)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("ListAllMessages failed: %v", err)
+		}
+
+		if len(messages) != 3 {
+			t.Fatalf("expected 3 messages, got %d", len(messages))
+		}
+		if messages[2].ID != "1" {
+			t.Errorf("last message ID = %q, want %q", messages[2].ID, "1")
+		}
+		if len(seenPages) != 2 || seenPages[0] != 1 || seenPages[1] != 2 {
+			t.Errorf("pages reported = %v, want [1 2]", seenPages)
+		}
+	})
+
+	t.Run("limit applies across pages", func(t *testing.T) {
+		messages, err := client.ListAllMessages(ListMessagesOptions{List: "git", Month: "202602", Limit: 2}, nil)
+		if err != nil {
+			t.Fatalf("ListAllMessages failed: %v", err)
+		}
+		if len(messages) != 2 {
+			t.Errorf("expected 2 messages, got %d", len(messages))
+		}
+	})
+}
+
+func TestListAllMessagesContextDeadline(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("r")
+		// Page 2 hangs until the client gives up; page 3 must never be requested
+		switch page {
+		case "2":
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		case "3":
+			t.Errorf("page 3 fetched after the deadline")
+		}
+		_, _ = io.WriteString(w, monthPage("git", Message{ID: page, Date: "2026-02-0" + page, Subject: "Page " + page, Author: "A"}))
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result, err := client.ListAllMessagesContext(ctx, ListMessagesOptions{List: "git", Month: "202602"}, nil)
+	if err != nil {
+		t.Fatalf("ListAllMessagesContext failed: %v", err)
+	}
+
+	// The fetch in flight is cancelled rather than waited out
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("listing took %v past a 50ms deadline", elapsed)
+	}
+	if !result.TimedOut || result.NextPage != 2 {
+		t.Errorf("TimedOut = %v, NextPage = %d; want true, 2", result.TimedOut, result.NextPage)
+	}
+	if result.List != "git" || result.Month != "202602" {
+		t.Errorf("resume point = %s/%s, want git/202602", result.List, result.Month)
+	}
+	if len(result.Messages) != 1 {
+		t.Errorf("expected the message fetched before the deadline, got %+v", result.Messages)
+	}
+}
+
+func TestListMessagesExclusions(t *testing.T) {
+	page := monthPage("git",
+		Message{ID: "4", Date: "2026-02-04", Subject: "[PATCH] real work", Author: "Alice"},
+		Message{ID: "3", Date: "2026-02-03", Subject: "Nightly build report", Author: "CI Bot <NoReply@ci.example.com>"},
+		Message{ID: "2", Date: "2026-02-02", Subject: "[no subject]", Author: "Bob"},
+		Message{ID: "1", Date: "2026-02-01", Subject: "Re: [PATCH] real work", Author: "Carol"},
+	)
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, page)
+	}))
+
+	opts := ListMessagesOptions{
+		List:            "git",
+		Month:           "202602",
+		ExcludeAuthors:  []string{"noreply@"},
+		ExcludeSubjects: []string{"[NO SUBJECT]"},
+	}
+
+	messages, err := client.ListMessagesWithOptions(opts)
+	if err != nil {
+		t.Fatalf("ListMessagesWithOptions failed: %v", err)
+	}
+	if len(messages) != 2 || messages[0].ID != "4" || messages[1].ID != "1" {
+		t.Errorf("expected messages [4 1], got %+v", messages)
+	}
+
+	// Exclusions run before the limit, so the limit counts kept messages
+	opts.Limit = 2
+	opts.Page = 2
+	limited, err := client.ListMessagesWithOptions(opts)
+	if err != nil {
+		t.Fatalf("ListMessagesWithOptions failed: %v", err)
+	}
+	if len(limited) != 2 || limited[1].ID != "1" {
+		t.Errorf("expected limit to apply after exclusion, got %+v", limited)
+	}
+
+	// The cache keeps the unfiltered page
+	opts = ListMessagesOptions{List: "git", Month: "202602"}
+	all, err := client.ListMessagesWithOptions(opts)
+	if err != nil {
+		t.Fatalf("ListMessagesWithOptions failed: %v", err)
+	}
+	if len(all) != 4 {
+		t.Errorf("expected 4 unfiltered messages, got %d", len(all))
+	}
+}
+
+// largeMonthPage builds a month listing with n messages surrounded by the
+// navigation chrome marc.info renders around the <pre> block.
+func largeMonthPage(n int) string {
+	var b strings.Builder
+	b.WriteString("<html><head><title>MARC: git</title></head><body>\n")
+	for i := 0; i < 40; i++ {
+		fmt.Fprintf(&b, "<a href=\"?l=git&b=2026%02d&w=2\">2026-%02d</a> navigation and chrome line %d\n", i%12+1, i%12+1, i)
+	}
+	b.WriteString("<pre>\n")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, "%4d. 2026-02-%02d  [%d] <a href=\"?l=git&m=1740375958%05d&w=2\">[PATCH v%d %d/%d] subsystem: fix a subtle race in component %d</a> <a href=\"?l=git&w=2\">git</a>  Developer Number %d\n",
+			i+1, i%28+1, i%5+1, i, i%3+1, i%7+1, 7, i, i)
+	}
+	b.WriteString("</pre>\n</body></html>")
+	return b.String()
+}
+
+func BenchmarkParseMessageList(b *testing.B) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError}))
+	page := largeMonthPage(1000)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(page)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		messages := parseMessageListFromRaw(page, "git", logger)
+		if len(messages) != 1000 {
+			b.Fatalf("expected 1000 messages, got %d", len(messages))
+		}
+	}
+}
+
+func TestDialTimeout(t *testing.T) {
+	const dialTimeout = 200 * time.Millisecond
+	const overall = 10 * time.Second
+
+	client := &http.Client{Timeout: overall, Transport: newTransport(dialTimeout)}
+
+	t.Run("TLS handshake", func(t *testing.T) {
+		// Accepts connections but never answers the TLS handshake
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("listen failed: %v", err)
+		}
+		defer ln.Close()
+		go func() {
+			for {
+				conn, err := ln.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+			}
+		}()
+
+		start := time.Now()
+		_, err = client.Get("https://" + ln.Addr().String() + "/")
+		elapsed := time.Since(start)
+
+		if err == nil || !strings.Contains(err.Error(), "TLS handshake timeout") {
+			t.Fatalf("expected TLS handshake timeout, got %v", err)
+		}
+		if elapsed >= overall/2 {
+			t.Errorf("handshake timeout took %s, expected about %s", elapsed, dialTimeout)
+		}
+	})
+
+	t.Run("unroutable address", func(t *testing.T) {
+		start := time.Now()
+		_, err := client.Get("http://10.255.255.1/")
+		elapsed := time.Since(start)
+
+		var netErr net.Error
+		if !errors.As(err, &netErr) || !netErr.Timeout() {
+			t.Skipf("network rejected the address instead of dropping it: %v", err)
+		}
+		if elapsed >= overall/2 {
+			t.Errorf("dial timeout took %s, expected about %s", elapsed, dialTimeout)
+		}
+	})
+}
+
+func TestWithContextLogsCorrelationID(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, messageHTML([]string{"Subject: hello", "From: Alice <alice@example.com>"}, "body"))
+	}))
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	ctx := logctx.WithCorrelationID(context.Background(), logger, "req-1")
+
+	// A miss followed by a hit logs from both the fetch path and the cache
+	scoped := c.WithContext(ctx)
+	for range 2 {
+		if _, err := scoped.GetMessage("git", "1"); err != nil {
+			t.Fatalf("GetMessage failed: %v", err)
+		}
+	}
+
+	var records int
+	for line := range strings.Lines(buf.String()) {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid log line %q: %v", line, err)
+		}
+		if record[logctx.CorrelationKey] != "req-1" {
+			t.Errorf("log record %v lacks the correlation ID", record)
+		}
+		records++
+	}
+	if records < 3 {
+		t.Errorf("expected fetch and cache log records, got %d:\n%s", records, buf.String())
+	}
+
+	if plain := c.WithContext(context.Background()); plain.logger != c.logger {
+		t.Error("expected WithContext without a logger to keep the client's logger")
+	}
+}
@@ -0,0 +1,122 @@
+package marc
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// decodeBody undoes the message's Content-Transfer-Encoding and transcodes
+// its charset to UTF-8. The charset comes from the Content-Type header,
+// falling back to fallbackCharset (usually the page's meta tag). Each step
+// leaves the body untouched when it cannot be applied, so the worst case is
+// the raw bytes marc.info served.
+func decodeBody(body string, headers map[string]string, fallbackCharset string) string {
+	switch strings.ToLower(strings.TrimSpace(headerValue(headers, "Content-Transfer-Encoding"))) {
+	case "quoted-printable":
+		if decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body))); err == nil {
+			body = string(decoded)
+		}
+	case "base64":
+		compact := strings.Join(strings.Fields(body), "")
+		if decoded, err := base64.StdEncoding.DecodeString(compact); err == nil {
+			body = string(decoded)
+		}
+	}
+
+	// Text that is already valid UTF-8 was either ASCII or converted by
+	// marc.info; transcoding it again would produce mojibake
+	if utf8.ValidString(body) {
+		return body
+	}
+
+	charset := contentTypeCharset(headerValue(headers, "Content-Type"))
+	if charset == "" {
+		charset = fallbackCharset
+	}
+	if charset == "" {
+		return body
+	}
+
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return body
+	}
+	decoded, err := enc.NewDecoder().String(body)
+	if err != nil {
+		return body
+	}
+	return decoded
+}
+
+func contentTypeCharset(contentType string) string {
+	if contentType == "" {
+		return ""
+	}
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	return params["charset"]
+}
+
+// metaCharset returns the charset declared by a <meta charset> or
+// <meta http-equiv="Content-Type"> tag, if any.
+func metaCharset(doc *html.Node) string {
+	var charset string
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if charset != "" {
+			return
+		}
+		if n.Type == html.ElementNode && n.Data == "meta" {
+			if cs := getAttr(n, "charset"); cs != "" {
+				charset = cs
+				return
+			}
+			if strings.EqualFold(getAttr(n, "http-equiv"), "content-type") {
+				charset = contentTypeCharset(getAttr(n, "content"))
+				return
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(doc)
+
+	return charset
+}
+
+// wordDecoder decodes RFC 2047 encoded-words in any charset htmlindex
+// knows, beyond the UTF-8, US-ASCII and ISO-8859-1 mime handles itself.
+var wordDecoder = &mime.WordDecoder{
+	CharsetReader: func(charset string, input io.Reader) (io.Reader, error) {
+		enc, err := htmlindex.Get(charset)
+		if err != nil {
+			return nil, err
+		}
+		return enc.NewDecoder().Reader(input), nil
+	},
+}
+
+// decodeHeaderWords decodes the RFC 2047 encoded-words ("=?UTF-8?B?...?=")
+// in a Subject or From value, joining adjacent ones and keeping plain text
+// around them. Values that fail to decode are returned unchanged.
+func decodeHeaderWords(s string) string {
+	if !strings.Contains(s, "=?") {
+		return s
+	}
+	decoded, err := wordDecoder.DecodeHeader(s)
+	if err != nil {
+		return s
+	}
+	return decoded
+}
@@ -0,0 +1,154 @@
+package marc
+
+import "testing"
+
+func TestParseMessageLatin1Body(t *testing.T) {
+	testHTML := "<html><body><pre>\n" +
+		"From: Fran\xe7ois\n" +
+		"Subject: Caf\xe9\n" +
+		"Content-Type: text/plain; charset=ISO-8859-1\n" +
+		"\n" +
+		"R\xe9sum\xe9 attach\xe9, voil\xe0.\n" +
+		"</pre></body></html>"
+
+	msg, err := parseMessage(testHTML, "test", "1")
+	if err != nil {
+		t.Fatalf("parseMessage failed: %v", err)
+	}
+
+	if msg.Body != "Résumé attaché, voilà." {
+		t.Errorf("Body = %q, want %q", msg.Body, "Résumé attaché, voilà.")
+	}
+}
+
+func TestParseMessageMetaCharset(t *testing.T) {
+	testHTML := "<html><head><meta http-equiv=\"Content-Type\" content=\"text/html; charset=windows-1252\"></head><body><pre>\n" +
+		"Subject: Quotes\n" +
+		"\n" +
+		"\x93smart quotes\x94\n" +
+		"</pre></body></html>"
+
+	msg, err := parseMessage(testHTML, "test", "1")
+	if err != nil {
+		t.Fatalf("parseMessage failed: %v", err)
+	}
+
+	if msg.Body != "“smart quotes”" {
+		t.Errorf("Body = %q, want %q", msg.Body, "“smart quotes”")
+	}
+}
+
+func TestDecodeBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		headers map[string]string
+		want    string
+	}{
+		{
+			"quoted-printable utf-8",
+			"Caf=C3=A9 au lait, with a soft =\nline break.",
+			map[string]string{
+				"Content-Type":              "text/plain; charset=utf-8",
+				"Content-Transfer-Encoding": "quoted-printable",
+			},
+			"Café au lait, with a soft line break.",
+		},
+		{
+			"quoted-printable latin-1",
+			"na=EFve",
+			map[string]string{
+				"content-type":              "text/plain; charset=\"iso-8859-1\"",
+				"content-transfer-encoding": "Quoted-Printable",
+			},
+			"naïve",
+		},
+		{
+			"base64",
+			"SGVsbG8s\nIHdvcmxk\n",
+			map[string]string{"Content-Transfer-Encoding": "base64"},
+			"Hello, world",
+		},
+		{
+			"already utf-8 is left alone",
+			"Déjà vu",
+			map[string]string{"Content-Type": "text/plain; charset=iso-8859-1"},
+			"Déjà vu",
+		},
+		{
+			"unknown charset falls back to raw bytes",
+			"caf\xe9",
+			map[string]string{"Content-Type": "text/plain; charset=x-made-up"},
+			"caf\xe9",
+		},
+		{
+			"invalid base64 falls back to raw text",
+			"not base64!",
+			map[string]string{"Content-Transfer-Encoding": "base64"},
+			"not base64!",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decodeBody(tt.body, tt.headers, "")
+			if got != tt.want {
+				t.Errorf("decodeBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeHeaderWords(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "Re: [PATCH] fix", "Re: [PATCH] fix"},
+		{"utf-8 base64", "=?UTF-8?B?0J/RgNC40LLQtdGC?=", "Привет"},
+		{"utf-8 quoted-printable", "=?utf-8?Q?Caf=C3=A9_cr=C3=A8me?=", "Café crème"},
+		{"iso-8859-1", "=?ISO-8859-1?Q?Fran=E7ois?= <f@example.com>", "François <f@example.com>"},
+		{"koi8-r", "=?KOI8-R?B?8NLJ18XU?=", "Привет"},
+		{"shift_jis", "=?Shift_JIS?B?k/qWe4zq?=", "日本語"},
+		{"adjacent words", "=?UTF-8?Q?Hello_?= =?UTF-8?Q?World?=", "Hello World"},
+		{"mixed", "Re: =?UTF-8?B?w7xiZXI=?= alles", "Re: über alles"},
+		{"unknown charset", "=?x-nonsense?Q?abc?=", "=?x-nonsense?Q?abc?="},
+		{"malformed", "=?UTF-8?B?not base64!?=", "=?UTF-8?B?not base64!?="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decodeHeaderWords(tt.in); got != tt.want {
+				t.Errorf("decodeHeaderWords(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEncodedSubjects(t *testing.T) {
+	line := `  1. 2026-02-24  [1] <a href="?l=git&m=1&w=2">=?UTF-8?Q?R=C3=A9sum=C3=A9?=</a> <a href="?l=git&w=2">git</a>  =?ISO-8859-1?Q?Fran=E7ois?=`
+	msg, ok := parseMessageLine(line, "git")
+	if !ok {
+		t.Fatal("parseMessageLine failed")
+	}
+	if msg.Subject != "Résumé" || msg.Author != "François" {
+		t.Errorf("listing subject/author = %q / %q", msg.Subject, msg.Author)
+	}
+
+	content, err := parseMessage("<html><body><pre>\n"+
+		"From: =?UTF-8?B?0JjQstCw0L0=?= &lt;ivan@example.com&gt;\n"+
+		"Subject: =?UTF-8?B?W1BBVENIIDEvMl0g0YLQtdGB0YI=?=\n"+
+		"\n"+
+		"body\n"+
+		"</pre></body></html>", "git", "1")
+	if err != nil {
+		t.Fatalf("parseMessage failed: %v", err)
+	}
+	if content.Subject != "[PATCH 1/2] тест" || content.Author != "Иван <ivan@example.com>" {
+		t.Errorf("message subject/author = %q / %q", content.Subject, content.Author)
+	}
+	if content.Patch == nil || content.Patch.Total != 2 {
+		t.Errorf("expected patch info from the decoded subject, got %+v", content.Patch)
+	}
+}
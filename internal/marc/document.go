@@ -0,0 +1,134 @@
+package marc
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// ThreadDocument renders the thread starting at rootMessageID as a single
+// text document, each message introduced by a
+// "--- Message N: <subject> by <author> on <date> ---" separator. With
+// stripQuotes, quoted ("> ") lines are dropped from the bodies. Documents
+// are cached per root and options.
+func (c *Client) ThreadDocument(list, rootMessageID string, stripQuotes bool) (string, error) {
+	list = c.normalizeList(list)
+
+	key := fmt.Sprintf("thread:%s:%s:strip=%t", list, rootMessageID, stripQuotes)
+
+	if cached, ok := c.cache.GetDocument(list, key); ok {
+		return cached, nil
+	}
+
+	thread, err := c.GetThread(list, rootMessageID)
+	if err != nil {
+		return "", err
+	}
+
+	doc := renderThread(thread, stripQuotes)
+	c.cache.SetDocument(list, key, doc)
+	return doc, nil
+}
+
+func renderThread(thread []MessageContent, stripQuotes bool) string {
+	var b strings.Builder
+	for i, msg := range thread {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		fmt.Fprintf(&b, "--- Message %d: %s by %s on %s ---\n", i+1, msg.Subject, msg.Author, msg.Date)
+
+		body := msg.Body
+		if stripQuotes {
+			body = stripQuotedLines(body)
+		}
+		b.WriteString(body)
+	}
+	return b.String()
+}
+
+// stripQuotedLines removes quoted reply lines and the runs of blank lines
+// they leave behind.
+func stripQuotedLines(body string) string {
+	lines := strings.Split(body, "\n")
+	kept := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimLeft(line, " \t"), ">") {
+			continue
+		}
+		if strings.TrimSpace(line) == "" && len(kept) > 0 && strings.TrimSpace(kept[len(kept)-1]) == "" {
+			continue
+		}
+		kept = append(kept, line)
+	}
+
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
+
+// ThreadHTML renders the thread starting at rootMessageID as a
+// self-contained HTML page, one section per message with its subject,
+// author, date and body in a <pre>. All message content is escaped. Pages
+// are cached per root.
+func (c *Client) ThreadHTML(list, rootMessageID string) (string, error) {
+	list = c.normalizeList(list)
+
+	key := fmt.Sprintf("thread-html:%s:%s", list, rootMessageID)
+
+	if cached, ok := c.cache.GetDocument(list, key); ok {
+		return cached, nil
+	}
+
+	thread, err := c.GetThread(list, rootMessageID)
+	if err != nil {
+		return "", err
+	}
+
+	page, err := renderThreadHTML(list, thread)
+	if err != nil {
+		return "", err
+	}
+	c.cache.SetDocument(list, key, page)
+	return page, nil
+}
+
+var threadHTMLTemplate = template.Must(template.New("thread").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; max-width: 60em; margin: 2em auto; padding: 0 1em; }
+section { border-top: 1px solid #ccc; padding: 1em 0; }
+.meta { color: #555; }
+pre { white-space: pre-wrap; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p class="meta">{{.List}}, {{len .Messages}} messages</p>
+{{range .Messages}}<section id="m{{.ID}}">
+<h2>{{.Subject}}</h2>
+<p class="meta">{{.Author}} &middot; {{.Date}}</p>
+<pre>{{.Body}}</pre>
+</section>
+{{end}}</body>
+</html>
+`))
+
+func renderThreadHTML(list string, thread []MessageContent) (string, error) {
+	data := struct {
+		Title    string
+		List     string
+		Messages []MessageContent
+	}{Title: "(empty thread)", List: list, Messages: thread}
+	if len(thread) > 0 {
+		data.Title = thread[0].Subject
+	}
+
+	var b strings.Builder
+	if err := threadHTMLTemplate.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,36 @@
+package marc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// fingerprintHeaders are the headers, in order, that a message fingerprint
+// covers besides the body.
+var fingerprintHeaders = []string{"Message-ID", "From", "Subject", "Date"}
+
+// MessageFingerprint returns the content fingerprint of a message (see
+// MessageContent.Fingerprint).
+func (c *Client) MessageFingerprint(list, messageID string) (string, error) {
+	msg, err := c.GetMessage(list, messageID)
+	if err != nil {
+		return "", err
+	}
+	return msg.Fingerprint, nil
+}
+
+// messageFingerprint hashes msg's identifying headers, with runs of
+// whitespace collapsed, and its trimmed body. Header names are matched
+// case-insensitively and missing headers hash as empty, so the result
+// only changes when the content does.
+func messageFingerprint(msg *MessageContent) string {
+	h := sha256.New()
+	for _, name := range fingerprintHeaders {
+		value := strings.Join(strings.Fields(headerValue(msg.Headers, name)), " ")
+		h.Write([]byte(strings.ToLower(name) + ": " + value + "\n"))
+	}
+	h.Write([]byte("\n"))
+	h.Write([]byte(strings.TrimSpace(msg.Body)))
+	return hex.EncodeToString(h.Sum(nil))
+}
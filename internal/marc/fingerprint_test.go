@@ -0,0 +1,90 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestMessageFingerprint(t *testing.T) {
+	base := func() *MessageContent {
+		return &MessageContent{
+			Headers: map[string]string{
+				"Message-ID": "<a@example.com>",
+				"From":       "Alice <alice@example.com>",
+				"Subject":    "[PATCH] fix crash",
+				"Date":       "Tue, 2 Jan 2024 10:00:00 +0000",
+				"X-Mailer":   "ignored",
+			},
+			Body: "The fix.\n",
+		}
+	}
+	want := messageFingerprint(base())
+
+	same := base()
+	same.Headers = map[string]string{
+		"message-id": "<a@example.com>",
+		"FROM":       "Alice  <alice@example.com>",
+		"Subject":    "[PATCH] fix\n crash",
+		"Date":       "Tue, 2 Jan 2024 10:00:00 +0000",
+	}
+	same.Body = "\n  The fix.  \n\n"
+	if got := messageFingerprint(same); got != want {
+		t.Errorf("equivalent content fingerprint = %s, want %s", got, want)
+	}
+
+	changed := base()
+	changed.Body = "The other fix.\n"
+	if got := messageFingerprint(changed); got == want {
+		t.Errorf("changed body kept fingerprint %s", got)
+	}
+
+	renamed := base()
+	renamed.Headers["Subject"] = "[PATCH v2] fix crash"
+	if got := messageFingerprint(renamed); got == want {
+		t.Errorf("changed subject kept fingerprint %s", got)
+	}
+}
+
+func TestGetMessageFingerprint(t *testing.T) {
+	body := "The fix."
+	fetches := 0
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		_, _ = io.WriteString(w, messageHTML([]string{
+			"From: Alice <alice@example.com>",
+			"Subject: [PATCH] fix crash",
+			"Message-ID: <a@example.com>",
+		}, body))
+	}))
+
+	msg, err := c.GetMessageWithOptions("git", "1", GetMessageOptions{PreserveWhitespace: true})
+	if err != nil {
+		t.Fatalf("GetMessage failed: %v", err)
+	}
+	if len(msg.Fingerprint) != 64 {
+		t.Fatalf("fingerprint = %q, want a hex SHA-256", msg.Fingerprint)
+	}
+
+	// Served from the cache with the stored fingerprint
+	got, err := c.MessageFingerprint("git", "1")
+	if err != nil {
+		t.Fatalf("MessageFingerprint failed: %v", err)
+	}
+	if got != msg.Fingerprint || fetches != 1 {
+		t.Errorf("fingerprint = %q after %d fetches, want %q from the cache", got, fetches, msg.Fingerprint)
+	}
+
+	// A refetch of changed content yields a new fingerprint
+	body = "The better fix."
+	if _, err := c.EvictMessage("git", "1"); err != nil {
+		t.Fatalf("EvictMessage failed: %v", err)
+	}
+	got, err = c.MessageFingerprint("git", "1")
+	if err != nil {
+		t.Fatalf("MessageFingerprint failed: %v", err)
+	}
+	if got == msg.Fingerprint {
+		t.Errorf("changed body kept fingerprint %s", got)
+	}
+}
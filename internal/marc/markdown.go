@@ -0,0 +1,133 @@
+package marc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MessageMarkdown renders a message as Markdown for pasting into issue
+// trackers: the subject as a heading, a metadata list and the body. Quoted
+// reply lines become Markdown blockquotes, nested as deep as they were
+// quoted; everything else is kept verbatim in fenced blocks.
+func (c *Client) MessageMarkdown(list, messageID string) (string, error) {
+	msg, err := c.GetMessage(list, messageID)
+	if err != nil {
+		return "", err
+	}
+	return renderMarkdown(msg), nil
+}
+
+func renderMarkdown(msg *MessageContent) string {
+	var b strings.Builder
+
+	subject := msg.Subject
+	if subject == "" {
+		subject = "(no subject)"
+	}
+	fmt.Fprintf(&b, "# %s\n\n", escapeMarkdown(subject))
+
+	fmt.Fprintf(&b, "- **From:** %s\n", escapeMarkdown(msg.Author))
+	fmt.Fprintf(&b, "- **Date:** %s\n", escapeMarkdown(msg.Date))
+	if id := headerValue(msg.Headers, "Message-ID"); id != "" {
+		fmt.Fprintf(&b, "- **Message-ID:** %s\n", escapeMarkdown(id))
+	}
+
+	for _, seg := range splitQuoted(msg.Body) {
+		b.WriteString("\n")
+		if seg.quoted {
+			for _, line := range seg.lines {
+				depth, text := quoteDepth(line)
+				b.WriteString(strings.TrimRight(strings.Repeat("> ", depth)+text, " "))
+				b.WriteString("\n")
+			}
+			continue
+		}
+
+		text := strings.Join(seg.lines, "\n")
+		fence := strings.Repeat("`", max(3, longestRun(text, '`')+1))
+		fmt.Fprintf(&b, "%s\n%s\n%s\n", fence, text, fence)
+	}
+
+	return b.String()
+}
+
+type bodySegment struct {
+	quoted bool
+	lines  []string
+}
+
+// splitQuoted splits a body into alternating runs of quoted and unquoted
+// lines. Unquoted runs are trimmed of surrounding blank lines and dropped
+// when nothing is left.
+func splitQuoted(body string) []bodySegment {
+	var segs []bodySegment
+	for _, line := range strings.Split(body, "\n") {
+		quoted := strings.HasPrefix(strings.TrimLeft(line, " \t"), ">")
+		if n := len(segs); n == 0 || segs[n-1].quoted != quoted {
+			segs = append(segs, bodySegment{quoted: quoted})
+		}
+		segs[len(segs)-1].lines = append(segs[len(segs)-1].lines, line)
+	}
+
+	kept := segs[:0]
+	for _, seg := range segs {
+		if !seg.quoted {
+			seg.lines = trimBlankLines(seg.lines)
+			if len(seg.lines) == 0 {
+				continue
+			}
+		}
+		kept = append(kept, seg)
+	}
+	return kept
+}
+
+// quoteDepth counts the quote markers starting line, accepting both ">>"
+// and "> >", and returns the text after them.
+func quoteDepth(line string) (int, string) {
+	depth := 0
+	rest := strings.TrimLeft(line, " \t")
+	for strings.HasPrefix(rest, ">") {
+		depth++
+		rest = strings.TrimLeft(rest[1:], " ")
+	}
+	return depth, rest
+}
+
+func trimBlankLines(lines []string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// longestRun returns the length of the longest run of r in s.
+func longestRun(s string, r rune) int {
+	longest, run := 0, 0
+	for _, c := range s {
+		if c == r {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	return longest
+}
+
+// markdownEscaper backslash-escapes the characters Markdown treats
+// specially within a line, including '<' and '>' so addresses are not
+// taken for HTML. Escaped text is only ever placed after a line prefix, so
+// line-start syntax such as "1." or "-" needs no escaping.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `~`, `\~`,
+	`[`, `\[`, `]`, `\]`, `#`, `\#`, `|`, `\|`, `&`, `\&`,
+	`<`, `\<`, `>`, `\>`,
+)
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
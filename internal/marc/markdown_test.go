@@ -0,0 +1,73 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMessageMarkdown(t *testing.T) {
+	body := strings.Join([]string{
+		"On Mon, Alice wrote:",
+		"> Should we *really* do this?",
+		">> Earlier point",
+		"> > Also earlier",
+		">",
+		"",
+		"Yes, see `foo_bar` below:",
+		"",
+		"```",
+		"code",
+		"```",
+	}, "\n")
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, messageHTML([]string{
+			"From: Bob <bob@example.com>",
+			"Subject: [PATCH v2 1/3] refs: add *helper*_x",
+			"Date: Tue, 24 Feb 2026 10:00:00 +0000",
+			"Message-ID: <abc@example.com>",
+		}, body))
+	}))
+
+	md, err := c.MessageMarkdown("git", "1")
+	if err != nil {
+		t.Fatalf("MessageMarkdown failed: %v", err)
+	}
+
+	wantPrefix := "# \\[PATCH v2 1/3\\] refs: add \\*helper\\*\\_x\n\n" +
+		"- **From:** Bob \\<bob@example.com\\>\n" +
+		"- **Date:** Tue, 24 Feb 2026 10:00:00 +0000\n" +
+		"- **Message-ID:** \\<abc@example.com\\>\n"
+	if !strings.HasPrefix(md, wantPrefix) {
+		t.Errorf("unexpected heading or metadata:\n%s", md)
+	}
+
+	wantQuotes := "> Should we *really* do this?\n" +
+		"> > Earlier point\n" +
+		"> > Also earlier\n" +
+		">\n"
+	if !strings.Contains(md, wantQuotes) {
+		t.Errorf("quotes not converted, got:\n%s", md)
+	}
+
+	if !strings.Contains(md, "```\nOn Mon, Alice wrote:\n```\n") {
+		t.Errorf("expected attribution in its own fenced block, got:\n%s", md)
+	}
+	// The reply contains a ``` fence itself, so a longer one is used
+	if !strings.Contains(md, "````\nYes, see `foo_bar` below:\n\n```\ncode\n```\n````\n") {
+		t.Errorf("expected reply in a longer fence, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdownWithoutMessageID(t *testing.T) {
+	md := renderMarkdown(&MessageContent{Message: Message{Author: "A", Date: "D"}, Body: "hi"})
+
+	if !strings.HasPrefix(md, "# (no subject)\n") {
+		t.Errorf("expected placeholder heading, got:\n%s", md)
+	}
+	if strings.Contains(md, "Message-ID") {
+		t.Errorf("expected no Message-ID line, got:\n%s", md)
+	}
+}
@@ -0,0 +1,93 @@
+package marc
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// asctime is the date layout of an mbox "From " separator line.
+const asctime = "Mon Jan _2 15:04:05 2006"
+
+// ExportMboxTo writes every message of a month to w in mboxrd format,
+// oldest first. Messages are fetched and written one at a time, so memory
+// use does not grow with the size of the month.
+func (c *Client) ExportMboxTo(w io.Writer, list, month string) error {
+	messages, err := c.ListAllMessages(ListMessagesOptions{List: list, Month: month}, nil)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Debug("exporting mbox", "list", list, "month", month, "messages", len(messages))
+
+	// Listings are newest first
+	var buf bytes.Buffer
+	for i := len(messages) - 1; i >= 0; i-- {
+		m := messages[i]
+
+		source, err := c.GetMessageSource(list, m.ID)
+		if err != nil {
+			return fmt.Errorf("message %s: %w", m.ID, err)
+		}
+
+		buf.Reset()
+		writeMboxMessage(&buf, source, m)
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportMbox is ExportMboxTo collecting the mbox into a string, for small
+// months.
+func (c *Client) ExportMbox(list, month string) (string, error) {
+	var b strings.Builder
+	if err := c.ExportMboxTo(&b, list, month); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// writeMboxMessage frames one RFC822 message: a "From " separator, the
+// message with mboxrd ">From " quoting and LF line endings, and a blank line.
+func writeMboxMessage(buf *bytes.Buffer, source string, m Message) {
+	sender, date := envelope(source, m)
+	fmt.Fprintf(buf, "From %s %s\n", sender, date.UTC().Format(asctime))
+
+	sc := bufio.NewScanner(strings.NewReader(source))
+	sc.Buffer(make([]byte, 0, 64*1024), len(source)+1)
+	for sc.Scan() {
+		line := strings.TrimSuffix(sc.Text(), "\r")
+		if strings.HasPrefix(strings.TrimLeft(line, ">"), "From ") {
+			buf.WriteByte('>')
+		}
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+}
+
+// envelope picks the sender address and date for the "From " line from the
+// message headers, falling back to the listing date.
+func envelope(source string, m Message) (string, time.Time) {
+	sender := "MAILER-DAEMON"
+	date, _ := time.Parse("2006-01-02", m.Date)
+
+	msg, err := mail.ReadMessage(strings.NewReader(source))
+	if err != nil {
+		return sender, date
+	}
+	if addr, err := mail.ParseAddress(msg.Header.Get("From")); err == nil {
+		sender = addr.Address
+	}
+	if d, err := msg.Header.Date(); err == nil {
+		date = d
+	}
+	return sender, date
+}
@@ -0,0 +1,63 @@
+package marc
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestExportMboxTo(t *testing.T) {
+	sources := map[string]string{
+		"1": "From: Alice <alice@example.com>\r\nDate: Sun, 1 Feb 2026 10:00:00 +0000\r\nSubject: First\r\n\r\nFrom the start\r\n>From a quote\r\n",
+		"2": "From: Bob <bob@example.com>\r\nDate: Mon, 2 Feb 2026 11:30:00 +0100\r\nSubject: Second\r\n\r\nSecond body\r\n",
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") == "raw" {
+			_, _ = io.WriteString(w, sources[q.Get("m")])
+			return
+		}
+		if q.Get("r") != "1" {
+			return
+		}
+		_, _ = io.WriteString(w, monthPage("git",
+			Message{ID: "2", Date: "2026-02-02", Subject: "Second", Author: "Bob"},
+			Message{ID: "1", Date: "2026-02-01", Subject: "First", Author: "Alice"},
+		))
+	}))
+
+	var buf bytes.Buffer
+	if err := client.ExportMboxTo(&buf, "git", "202602"); err != nil {
+		t.Fatalf("ExportMboxTo failed: %v", err)
+	}
+
+	want := "From alice@example.com Sun Feb  1 10:00:00 2026\n" +
+		"From: Alice <alice@example.com>\n" +
+		"Date: Sun, 1 Feb 2026 10:00:00 +0000\n" +
+		"Subject: First\n" +
+		"\n" +
+		">From the start\n" +
+		">>From a quote\n" +
+		"\n" +
+		"From bob@example.com Mon Feb  2 10:30:00 2026\n" +
+		"From: Bob <bob@example.com>\n" +
+		"Date: Mon, 2 Feb 2026 11:30:00 +0100\n" +
+		"Subject: Second\n" +
+		"\n" +
+		"Second body\n" +
+		"\n"
+
+	if buf.String() != want {
+		t.Errorf("mbox =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	mbox, err := client.ExportMbox("git", "202602")
+	if err != nil {
+		t.Fatalf("ExportMbox failed: %v", err)
+	}
+	if mbox != want {
+		t.Error("ExportMbox differs from ExportMboxTo")
+	}
+}
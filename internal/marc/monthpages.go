@@ -0,0 +1,116 @@
+package marc
+
+import (
+	"sync"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+// monthPages remembers which listing pages of each month were fetched, so
+// a cached month is only served as page 1 when it holds exactly pages 1..n
+// and the cursor after it is known. It is shared by pointer so copies of a
+// Client agree.
+type monthPages struct {
+	mu     sync.Mutex
+	months map[string]*fetchedPages
+}
+
+// fetchedPages are pages 1..pages of a month listing, fetched in order.
+type fetchedPages struct {
+	pages int
+	// hasNext reports whether the last of them linked to a further page.
+	hasNext bool
+	ids     map[string]bool
+	// first are the IDs listed on page 1.
+	first map[string]bool
+}
+
+func newMonthPages() *monthPages {
+	return &monthPages{months: make(map[string]*fetchedPages)}
+}
+
+func monthPagesKey(list, month string) string {
+	return list + "/" + month
+}
+
+// recordListingPage notes that page of the month listing was fetched.
+// Page 1 starts the record over; the page after the last one recorded
+// extends it. Any other page forgets the month, since the cache then holds
+// messages of a page that does not follow on from page 1.
+func (c *Client) recordListingPage(list, month string, page int, listing *listingPage) {
+	key := monthPagesKey(list, month)
+	c.monthPages.mu.Lock()
+	defer c.monthPages.mu.Unlock()
+
+	fetched := c.monthPages.months[key]
+	switch {
+	case page == 1:
+		fetched = &fetchedPages{ids: make(map[string]bool), first: make(map[string]bool)}
+		for _, m := range listing.messages {
+			fetched.first[m.ID] = true
+		}
+		c.monthPages.months[key] = fetched
+	case fetched != nil && page == fetched.pages+1:
+	default:
+		delete(c.monthPages.months, key)
+		return
+	}
+
+	fetched.pages = page
+	fetched.hasNext = listing.hasNext
+	for _, m := range listing.messages {
+		fetched.ids[m.ID] = true
+	}
+}
+
+// cachedPagesNext reports whether cached, the cached messages of a month,
+// are exactly the pages 1..n fetched in order, and if so the page that
+// follows them (0 when the month ends there). Messages that expired, were
+// evicted or were cached some other way make it false.
+func (c *Client) cachedPagesNext(list, month string, cached []cache.Message) (nextPage int, ok bool) {
+	c.monthPages.mu.Lock()
+	defer c.monthPages.mu.Unlock()
+
+	fetched, ok := c.cachedPages(list, month, cached)
+	if !ok {
+		return 0, false
+	}
+	if fetched.hasNext {
+		return fetched.pages + 1, true
+	}
+	return 0, true
+}
+
+// cachedFirstPage returns the messages of cached listed on page 1, when
+// cached holds the pages 1..n fetched in order.
+func (c *Client) cachedFirstPage(list, month string, cached []cache.Message) ([]cache.Message, bool) {
+	c.monthPages.mu.Lock()
+	defer c.monthPages.mu.Unlock()
+
+	fetched, ok := c.cachedPages(list, month, cached)
+	if !ok {
+		return nil, false
+	}
+	var first []cache.Message
+	for _, m := range cached {
+		if fetched.first[m.ID] {
+			first = append(first, m)
+		}
+	}
+	return first, true
+}
+
+// cachedPages returns the pages recorded for the month if cached holds
+// exactly their messages. The caller must hold c.monthPages.mu.
+func (c *Client) cachedPages(list, month string, cached []cache.Message) (*fetchedPages, bool) {
+	fetched := c.monthPages.months[monthPagesKey(list, month)]
+	if fetched == nil || len(cached) != len(fetched.ids) {
+		return nil, false
+	}
+	for _, m := range cached {
+		if !fetched.ids[m.ID] {
+			return nil, false
+		}
+	}
+	return fetched, true
+}
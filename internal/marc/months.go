@@ -0,0 +1,91 @@
+package marc
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"golang.org/x/net/html"
+)
+
+// ErrNoArchives is returned when a list has no archived messages.
+var ErrNoArchives = errors.New("no archived messages")
+
+// ListMonths returns the YYYYMM months marc.info has archived for a list,
+// oldest first.
+func (c *Client) ListMonths(list string) ([]string, error) {
+	list = c.normalizeList(list)
+
+	c.logger.Debug("listing months", "list", list)
+
+	raw, list, err := c.fetchList(monthIndexPath(list), list)
+	if err != nil {
+		return nil, err
+	}
+
+	months := parseMonthIndex(raw, list)
+	c.logger.Debug("found months", "list", list, "count", len(months))
+	return months, nil
+}
+
+// parseMonthIndex collects the months linked from a list's index page
+// (links carrying b=YYYYMM for the list and no message id).
+func parseMonthIndex(raw, list string) []string {
+	seen := make(map[string]bool)
+	months := make([]string, 0)
+
+	for _, m := range pageLinkRegex.FindAllStringSubmatch(raw, -1) {
+		q, err := url.ParseQuery(html.UnescapeString(m[1]))
+		if err != nil || q.Get("l") != list || q.Get("m") != "" {
+			continue
+		}
+		month := q.Get("b")
+		if !validMonth(month) || seen[month] {
+			continue
+		}
+		seen[month] = true
+		months = append(months, month)
+	}
+
+	sort.Strings(months)
+	return months
+}
+
+// EarliestMessage returns the oldest archived message of a list: the last
+// entry on the last page of its earliest month.
+func (c *Client) EarliestMessage(list string) (*Message, error) {
+	list = c.normalizeList(list)
+
+	months, err := c.ListMonths(list)
+	if err != nil {
+		return nil, err
+	}
+	if len(months) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoArchives, list)
+	}
+	month := months[0]
+
+	var last []Message
+	for page := 1; page <= maxMonthPages; page++ {
+		messages, hasNext, err := c.fetchMessagePage(list, month, page)
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) > 0 {
+			c.storeMessages(messages)
+			last = messages
+		}
+		if !hasNext {
+			break
+		}
+	}
+
+	if len(last) == 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoArchives, list, month)
+	}
+
+	// Listings are newest first
+	oldest := last[len(last)-1]
+	return &oldest, nil
+}
@@ -0,0 +1,118 @@
+package marc
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// monthIndex renders a list's month index page linking to months.
+func monthIndex(list string, months ...string) string {
+	var b strings.Builder
+	b.WriteString("<html><body><pre>\n")
+	for _, m := range months {
+		fmt.Fprintf(&b, "<a href=\"?l=%s&amp;r=1&amp;b=%s&amp;w=2\">%s-%s</a> [42]\n", list, m, m[:4], m[4:])
+	}
+	b.WriteString("</pre></body></html>")
+	return b.String()
+}
+
+func TestParseMonthIndex(t *testing.T) {
+	raw := monthIndex("git", "202602", "199807", "202601") +
+		`<a href="?l=git&m=123&w=2">msg</a> <a href="?l=other&b=199001&w=2">other</a> <a href="?l=git&b=202602&r=2&w=2">dup</a>`
+
+	got := parseMonthIndex(raw, "git")
+	if strings.Join(got, ",") != "199807,202601,202602" {
+		t.Errorf("parseMonthIndex() = %v", got)
+	}
+}
+
+func TestEarliestMessage(t *testing.T) {
+	nextLink := `[<a href="?l=%s&amp;r=2&amp;b=%s&amp;w=2">Next</a>]`
+
+	tests := []struct {
+		name    string
+		list    string            // defaults to git
+		pages   map[string]string // keyed by "b/r"; "index" for the month index
+		wantID  string
+		wantErr error
+	}{
+		{
+			name: "walks to last page of earliest month",
+			pages: map[string]string{
+				"index": monthIndex("git", "202602", "199807"),
+				"199807/1": strings.Replace(monthPage("git",
+					Message{ID: "3", Date: "1998-07-30", Subject: "Third", Author: "C"},
+				), "</pre>", "</pre>"+fmt.Sprintf(nextLink, "git", "199807"), 1),
+				"199807/2": monthPage("git",
+					Message{ID: "2", Date: "1998-07-02", Subject: "Second", Author: "B"},
+					Message{ID: "1", Date: "1998-07-01", Subject: "First", Author: "A"},
+				),
+			},
+			wantID: "1",
+		},
+		{
+			name: "single month",
+			pages: map[string]string{
+				"index": monthIndex("git", "202602"),
+				"202602/1": monthPage("git",
+					Message{ID: "9", Date: "2026-02-09", Subject: "Only", Author: "A"},
+				),
+			},
+			wantID: "9",
+		},
+		{
+			name: "resolves an alias",
+			list: "git-list",
+			pages: map[string]string{
+				"index": monthIndex("git", "202602"),
+				"202602/1": monthPage("git",
+					Message{ID: "9", Date: "2026-02-09", Subject: "Only", Author: "A"},
+				),
+			},
+			wantID: "9",
+		},
+		{
+			name:    "no archives",
+			pages:   map[string]string{"index": "<html><body>No messages</body></html>"},
+			wantErr: ErrNoArchives,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query()
+				if q.Get("l") != "git" {
+					t.Errorf("fetched list %q, want git", q.Get("l"))
+				}
+				key := "index"
+				if q.Get("b") != "" {
+					key = q.Get("b") + "/" + q.Get("r")
+				}
+				_, _ = io.WriteString(w, tt.pages[key])
+			}))
+
+			client.listAliases = map[string]string{"git-list": "git"}
+
+			list := cmp.Or(tt.list, "git")
+			msg, err := client.EarliestMessage(list)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("EarliestMessage failed: %v", err)
+			}
+			if msg.ID != tt.wantID {
+				t.Errorf("earliest message = %s, want %s", msg.ID, tt.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package marc
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+)
+
+// Orders accepted by ListMessagesOptions.Order and SearchCached.
+const (
+	OrderDesc = "desc"
+	OrderAsc  = "asc"
+)
+
+// ErrInvalidOrder is returned for an order other than OrderDesc or OrderAsc.
+var ErrInvalidOrder = errors.New("invalid order")
+
+func validOrder(order string) bool {
+	return order == "" || order == OrderDesc || order == OrderAsc
+}
+
+// sortMessages orders listed messages by date, newest first unless order
+// is OrderAsc. Same-day messages are ordered by ID, so either order is the
+// exact reverse of the other.
+func sortMessages(messages []Message, order string) {
+	slices.SortStableFunc(messages, func(a, b Message) int {
+		c := cmp.Or(cmp.Compare(a.Date, b.Date), compareIDs(a.ID, b.ID))
+		if order == OrderAsc {
+			return c
+		}
+		return -c
+	})
+}
+
+// compareIDs compares numeric message IDs without parsing them.
+func compareIDs(a, b string) int {
+	return cmp.Or(cmp.Compare(len(a), len(b)), cmp.Compare(a, b))
+}
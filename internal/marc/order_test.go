@@ -0,0 +1,63 @@
+package marc
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListMessagesOrder(t *testing.T) {
+	fetches := 0
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		// marc lists newest first; same-day messages are not always in ID order
+		_, _ = io.WriteString(w, monthPage("git",
+			Message{ID: "105", Date: "2024-01-03", Subject: "c", Author: "A"},
+			Message{ID: "98", Date: "2024-01-02", Subject: "b", Author: "A"},
+			Message{ID: "104", Date: "2024-01-02", Subject: "b", Author: "A"},
+			Message{ID: "99", Date: "2024-01-01", Subject: "a", Author: "A"},
+		))
+	}))
+
+	ids := func(messages []Message) []string {
+		var ids []string
+		for _, m := range messages {
+			ids = append(ids, m.ID)
+		}
+		return ids
+	}
+
+	// The first call parses the live page, the second reads the cache
+	desc, err := c.ListMessagesPage(ListMessagesOptions{List: "git", Month: "202401"})
+	if err != nil {
+		t.Fatalf("ListMessagesPage failed: %v", err)
+	}
+	asc, err := c.ListMessagesPage(ListMessagesOptions{List: "git", Month: "202401", Order: OrderAsc})
+	if err != nil {
+		t.Fatalf("ListMessagesPage failed: %v", err)
+	}
+	if fetches != 1 {
+		t.Errorf("expected the second listing from the cache, got %d fetches", fetches)
+	}
+
+	if want := []string{"105", "104", "98", "99"}; !reflect.DeepEqual(ids(desc.Messages), want) {
+		t.Errorf("desc = %v, want %v", ids(desc.Messages), want)
+	}
+	if want := []string{"99", "98", "104", "105"}; !reflect.DeepEqual(ids(asc.Messages), want) {
+		t.Errorf("asc = %v, want %v", ids(asc.Messages), want)
+	}
+
+	all, err := c.ListAllMessages(ListMessagesOptions{List: "git", Month: "202401", Order: OrderAsc}, nil)
+	if err != nil {
+		t.Fatalf("ListAllMessages failed: %v", err)
+	}
+	if !reflect.DeepEqual(ids(all), ids(asc.Messages)) {
+		t.Errorf("all pages asc = %v, want %v", ids(all), ids(asc.Messages))
+	}
+
+	if _, err := c.ListMessagesPage(ListMessagesOptions{List: "git", Order: "sideways"}); !errors.Is(err, ErrInvalidOrder) {
+		t.Errorf("expected ErrInvalidOrder, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package marc
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// pageSizeCache remembers the listing page size observed for each list.
+// It is shared by pointer so copies of a Client agree.
+type pageSizeCache struct {
+	mu    sync.Mutex
+	sizes map[string]int
+}
+
+func newPageSizeCache() *pageSizeCache {
+	return &pageSizeCache{sizes: make(map[string]int)}
+}
+
+// detectedPageSize returns the page size observed for list, if any.
+func (c *Client) detectedPageSize(list string) (int, bool) {
+	c.pageSizes.mu.Lock()
+	defer c.pageSizes.mu.Unlock()
+	n, ok := c.pageSizes.sizes[list]
+	return n, ok
+}
+
+// pageSize returns the page size observed for list, or messagesPerPage
+// until a full page has been seen.
+func (c *Client) pageSize(list string) int {
+	if n, ok := c.detectedPageSize(list); ok {
+		return n
+	}
+	return messagesPerPage
+}
+
+// recordPageSize remembers the size of a page known to be full, i.e. one
+// that links to a following page.
+func (c *Client) recordPageSize(list string, n int) {
+	if n <= 0 {
+		return
+	}
+	c.pageSizes.mu.Lock()
+	defer c.pageSizes.mu.Unlock()
+	if c.pageSizes.sizes[list] != n {
+		c.logger.Debug("detected page size", "list", list, "size", n)
+		c.pageSizes.sizes[list] = n
+	}
+}
+
+// DetectPageSize returns how many messages marc.info shows per listing page
+// for list. Any full page fetched earlier answers it; otherwise the first
+// page of month (default current month) is fetched and counted. When that
+// month fits on a single page the size cannot be observed: messagesPerPage
+// is returned as an assumption, with detected false, and is not remembered.
+func (c *Client) DetectPageSize(list, month string) (size int, detected bool, err error) {
+	list = c.normalizeList(list)
+
+	if n, ok := c.detectedPageSize(list); ok {
+		return n, true, nil
+	}
+
+	if month == "" {
+		month = time.Now().Format("200601")
+	}
+	if !validMonth(month) {
+		return 0, false, fmt.Errorf("%w %q: expected YYYYMM", ErrInvalidMonth, month)
+	}
+
+	messages, _, err := c.fetchMessagePage(list, month, 1)
+	if err != nil {
+		return 0, false, err
+	}
+	c.storeMessages(messages)
+
+	size, detected = c.detectedPageSize(list)
+	if !detected {
+		size = messagesPerPage
+	}
+	return size, detected, nil
+}
@@ -0,0 +1,90 @@
+package marc
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDetectPageSize(t *testing.T) {
+	var full []Message
+	for i := 7; i > 2; i-- {
+		full = append(full, Message{ID: fmt.Sprint(i), Date: "2026-02-01", Subject: "s", Author: "a"})
+	}
+	first := strings.Replace(monthPage("git", full...),
+		"</pre>", "</pre>[<a href=\"?l=git&amp;r=2&amp;b=202602&amp;w=2\">Next</a>]", 1)
+	last := monthPage("git",
+		Message{ID: "2", Date: "2026-02-01", Subject: "s", Author: "a"},
+		Message{ID: "1", Date: "2026-02-01", Subject: "s", Author: "a"},
+	)
+
+	var requests atomic.Int32
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch {
+		case r.URL.Query().Get("l") == "small":
+			_, _ = io.WriteString(w, monthPage("small", Message{ID: "1", Date: "2026-02-01", Subject: "s", Author: "a"}))
+		case r.URL.Query().Get("r") == "1":
+			_, _ = io.WriteString(w, first)
+		case r.URL.Query().Get("r") == "2":
+			_, _ = io.WriteString(w, last)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+
+	size, detected, err := client.DetectPageSize("git", "202602")
+	if err != nil {
+		t.Fatalf("DetectPageSize failed: %v", err)
+	}
+	if size != 5 || !detected {
+		t.Errorf("page size = %d, detected %v; want 5, true", size, detected)
+	}
+
+	requests.Store(0)
+	if again, detected, _ := client.DetectPageSize("git", ""); again != 5 || !detected || requests.Load() != 0 {
+		t.Errorf("expected remembered size 5 without requests, got %d (detected %v) after %d requests", again, detected, requests.Load())
+	}
+
+	t.Run("all pages stop at the short page", func(t *testing.T) {
+		requests.Store(0)
+		messages, err := client.ListAllMessages(ListMessagesOptions{List: "git", Month: "202602"}, nil)
+		if err != nil {
+			t.Fatalf("ListAllMessages failed: %v", err)
+		}
+		if len(messages) != 7 {
+			t.Errorf("expected 7 messages, got %d", len(messages))
+		}
+		if requests.Load() != 2 {
+			t.Errorf("expected 2 requests, got %d", requests.Load())
+		}
+	})
+
+	t.Run("cached listing pages end the month", func(t *testing.T) {
+		requests.Store(0)
+		page, err := client.ListMessagesPage(ListMessagesOptions{List: "git", Month: "202602"})
+		if err != nil {
+			t.Fatalf("ListMessagesPage failed: %v", err)
+		}
+		// Both pages were fetched, the second being the last
+		if len(page.Messages) != 7 || page.NextPage != 0 || requests.Load() != 0 {
+			t.Errorf("got %d messages, NextPage %d after %d requests; want 7, 0 from the cache", len(page.Messages), page.NextPage, requests.Load())
+		}
+	})
+
+	t.Run("single page month falls back to default", func(t *testing.T) {
+		size, detected, err := client.DetectPageSize("small", "202602")
+		if err != nil {
+			t.Fatalf("DetectPageSize failed: %v", err)
+		}
+		if size != messagesPerPage || detected {
+			t.Errorf("page size = %d, detected %v; want assumed default %d", size, detected, messagesPerPage)
+		}
+		if _, ok := client.detectedPageSize("small"); ok {
+			t.Error("expected size of a single short page not to be remembered")
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package marc
+
+import (
+	"context"
+	"sync"
+)
+
+// forEachLimited calls fn for every index below n using at most workers
+// goroutines, and returns once all calls have finished. Requests made by fn
+// are additionally spaced by the client's rate limit.
+func forEachLimited(workers, n int, fn func(i int)) {
+	forEachLimitedContext(context.Background(), workers, n, fn)
+}
+
+// forEachLimitedContext is forEachLimited that stops starting new calls
+// once ctx is done; calls already running still finish. It returns how many
+// indices were never started.
+func forEachLimitedContext(ctx context.Context, workers, n int, fn func(i int)) (skipped int) {
+	queue := make(chan int)
+	var wg sync.WaitGroup
+
+	for range min(workers, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				fn(i)
+			}
+		}()
+	}
+
+dispatch:
+	for i := range n {
+		if ctx.Err() != nil {
+			skipped = n - i
+			break
+		}
+		select {
+		case queue <- i:
+		case <-ctx.Done():
+			skipped = n - i
+			break dispatch
+		}
+	}
+	close(queue)
+	wg.Wait()
+	return skipped
+}
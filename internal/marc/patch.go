@@ -0,0 +1,81 @@
+package marc
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PatchInfo is the series metadata encoded in a patch subject such as
+// "[PATCH v3 2/5] foo: fix bar".
+type PatchInfo struct {
+	// Version is the series revision, 1 unless tagged "vN".
+	Version int `json:"version"`
+	// Index and Total are the "N/M" position; a cover letter is 0/M and an
+	// untagged single patch is 1/1.
+	Index   int  `json:"index"`
+	Total   int  `json:"total"`
+	IsPatch bool `json:"is_patch"`
+}
+
+var (
+	// Match the bracketed tags at the start of a subject: [RFC][PATCH v2 1/3]
+	subjectTagRegex = regexp.MustCompile(`^\s*\[([^\]]*)\]`)
+	// Match a series position inside a tag: 2/5
+	seriesPositionRegex = regexp.MustCompile(`^(\d+)/(\d+)$`)
+	// Match a version inside a tag, on its own or glued to PATCH: v3, PATCHv3
+	seriesVersionRegex = regexp.MustCompile(`(?i)^(?:patch)?v(\d+)$`)
+)
+
+// ParsePatchSubject extracts series metadata from the bracketed tags that
+// start subject, e.g. "[PATCH]", "[PATCH v2 1/3]" or "[RFC PATCH 3/7]".
+// Tags are split on whitespace and unknown words such as RFC, RESEND or a
+// subsystem name are ignored. ok is false when no tag mentions PATCH or a
+// series position; replies ("Re: [PATCH ...]") are therefore not matched.
+func ParsePatchSubject(subject string) (info PatchInfo, ok bool) {
+	for {
+		m := subjectTagRegex.FindStringSubmatchIndex(subject)
+		if m == nil {
+			break
+		}
+		tag := subject[m[2]:m[3]]
+		subject = subject[m[1]:]
+
+		for _, word := range strings.Fields(tag) {
+			switch {
+			case strings.EqualFold(word, "PATCH"):
+				info.IsPatch = true
+			case seriesVersionRegex.MatchString(word):
+				info.IsPatch = info.IsPatch || strings.HasPrefix(strings.ToLower(word), "patch")
+				info.Version, _ = strconv.Atoi(seriesVersionRegex.FindStringSubmatch(word)[1])
+			case seriesPositionRegex.MatchString(word):
+				pos := seriesPositionRegex.FindStringSubmatch(word)
+				info.Index, _ = strconv.Atoi(pos[1])
+				info.Total, _ = strconv.Atoi(pos[2])
+				ok = true
+			}
+		}
+		ok = ok || info.IsPatch
+	}
+
+	if !ok {
+		return PatchInfo{}, false
+	}
+	if info.Version == 0 {
+		info.Version = 1
+	}
+	if info.Total == 0 {
+		info.Index, info.Total = 1, 1
+	}
+	return info, true
+}
+
+// patchInfo returns the PatchInfo for a Message, or nil when subject is not
+// part of a patch series.
+func patchInfo(subject string) *PatchInfo {
+	info, ok := ParsePatchSubject(subject)
+	if !ok {
+		return nil
+	}
+	return &info
+}
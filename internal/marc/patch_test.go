@@ -0,0 +1,57 @@
+package marc
+
+import "testing"
+
+func TestParsePatchSubject(t *testing.T) {
+	tests := []struct {
+		subject string
+		want    PatchInfo
+		ok      bool
+	}{
+		{"[PATCH] fix typo", PatchInfo{Version: 1, Index: 1, Total: 1, IsPatch: true}, true},
+		{"[PATCH 1/1] fix typo", PatchInfo{Version: 1, Index: 1, Total: 1, IsPatch: true}, true},
+		{"[PATCH v2] fix typo", PatchInfo{Version: 2, Index: 1, Total: 1, IsPatch: true}, true},
+		{"[PATCH v3 2/5] refs: rework locking", PatchInfo{Version: 3, Index: 2, Total: 5, IsPatch: true}, true},
+		{"[PATCH v3 0/5] refs: rework locking", PatchInfo{Version: 3, Index: 0, Total: 5, IsPatch: true}, true},
+		{"[RFC PATCH 3/7] mm: try something", PatchInfo{Version: 1, Index: 3, Total: 7, IsPatch: true}, true},
+		{"[RFC][PATCH v2 1/3] split tags", PatchInfo{Version: 2, Index: 1, Total: 3, IsPatch: true}, true},
+		{"[PATCH net-next v4 10/12] net: subsystem tag", PatchInfo{Version: 4, Index: 10, Total: 12, IsPatch: true}, true},
+		{"[PATCHv5 2/2] glued version", PatchInfo{Version: 5, Index: 2, Total: 2, IsPatch: true}, true},
+		{"[patch V2] lowercase", PatchInfo{Version: 2, Index: 1, Total: 1, IsPatch: true}, true},
+		{"[PATCH RESEND 2/3] resent", PatchInfo{Version: 1, Index: 2, Total: 3, IsPatch: true}, true},
+		{"  [PATCH 1/2] leading space", PatchInfo{Version: 1, Index: 1, Total: 2, IsPatch: true}, true},
+		{"[RFC 1/3] position without PATCH", PatchInfo{Version: 1, Index: 1, Total: 3}, true},
+		{"Re: [PATCH v2 1/3] reply", PatchInfo{}, false},
+		{"[ANNOUNCE] Git v2.44.0", PatchInfo{}, false},
+		{"[RFC] design discussion", PatchInfo{}, false},
+		{"plain subject", PatchInfo{}, false},
+		{"fix [PATCH] in the middle", PatchInfo{}, false},
+		{"", PatchInfo{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.subject, func(t *testing.T) {
+			got, ok := ParsePatchSubject(tt.subject)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("ParsePatchSubject(%q) = %+v, %v; want %+v, %v", tt.subject, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestParseMessageLinePatchInfo(t *testing.T) {
+	line := `  1. 2026-02-24  [1] <a href="?l=git&m=1&w=2">[PATCH v2 1/3] refs: fix</a> <a href="?l=git&w=2">git</a>  Alice`
+
+	msg, ok := parseMessageLine(line, "git")
+	if !ok {
+		t.Fatal("parseMessageLine failed")
+	}
+	if msg.Patch == nil || *msg.Patch != (PatchInfo{Version: 2, Index: 1, Total: 3, IsPatch: true}) {
+		t.Errorf("Patch = %+v", msg.Patch)
+	}
+
+	line = `  2. 2026-02-24  [1] <a href="?l=git&m=2&w=2">Re: [PATCH v2 1/3] refs: fix</a> <a href="?l=git&w=2">git</a>  Bob`
+	if msg, _ := parseMessageLine(line, "git"); msg.Patch != nil {
+		t.Errorf("expected no patch info on a reply, got %+v", msg.Patch)
+	}
+}
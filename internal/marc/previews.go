@@ -0,0 +1,124 @@
+package marc
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+)
+
+// previewWorkers bounds how many messages MonthPreviews fetches at once.
+const previewWorkers = 4
+
+// MessagePreview is a listed message with the opening lines of its body.
+type MessagePreview struct {
+	Message
+	// Preview is empty when the body was not available (see MonthPreviews)
+	// or has no original text.
+	Preview string `json:"preview"`
+}
+
+// MonthPreviews lists a month like ListMessages and previews each body
+// with up to lines of its original text (see bodyPreview).
+// Bodies missing from the cache are fetched, at most previewWorkers at a
+// time and subject to the rate limit; a body that fails to fetch is logged
+// and leaves its preview empty. With cachedOnly nothing is fetched: the
+// listing and bodies come from the cache, expired or not, and uncached
+// bodies have no preview.
+func (c *Client) MonthPreviews(list, month string, lines int, cachedOnly bool) ([]MessagePreview, error) {
+	result, err := c.MonthPreviewsContext(context.Background(), list, month, lines, cachedOnly)
+	if err != nil {
+		return nil, err
+	}
+	return result.Previews, nil
+}
+
+// MonthPreviewsResult holds the previews of a month. TimedOut is set when
+// the context ended before every body was fetched; the NotFetched messages
+// left have an empty preview.
+type MonthPreviewsResult struct {
+	Previews   []MessagePreview `json:"previews"`
+	TimedOut   bool             `json:"timed_out,omitempty"`
+	NotFetched int              `json:"not_fetched,omitempty"`
+}
+
+// MonthPreviewsContext is MonthPreviews that stops fetching bodies once ctx
+// is done and returns the previews gathered so far. Fetches still running
+// are cancelled and counted as not fetched; a listing cut off itself
+// returns no previews.
+func (c *Client) MonthPreviewsContext(ctx context.Context, list, month string, lines int, cachedOnly bool) (*MonthPreviewsResult, error) {
+	c = c.WithContext(ctx)
+	opts, err := c.resolveListOptions(ListMessagesOptions{List: list, Month: month})
+	if err != nil {
+		return nil, err
+	}
+	lines = max(lines, 1)
+
+	var messages []Message
+	if cachedOnly {
+		cached, _ := c.cache.GetStaleMessages(opts.List, opts.Month)
+		for _, cm := range cached {
+			messages = append(messages, messageFromCache(cm))
+		}
+	} else {
+		messages, err = c.ListMessagesWithOptions(opts)
+		if err != nil && ctx.Err() != nil {
+			c.logger.Debug("month previews cut off", "list", opts.List, "month", opts.Month, "error", err)
+			return &MonthPreviewsResult{Previews: []MessagePreview{}, TimedOut: true}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	previews := make([]MessagePreview, len(messages))
+	for i, m := range messages {
+		previews[i].Message = m
+	}
+
+	var cut atomic.Int32
+	skipped := forEachLimitedContext(ctx, previewWorkers, len(previews), func(i int) {
+		p := &previews[i]
+		if cachedOnly {
+			if cached, ok := c.cache.GetStaleMessageContent(opts.List, p.ID); ok {
+				p.Preview = bodyPreview(cached.Body, lines)
+			}
+			return
+		}
+
+		msg, err := c.getMessageVerbatim(opts.List, p.ID)
+		if err != nil && ctx.Err() != nil {
+			cut.Add(1)
+			return
+		}
+		if err != nil {
+			c.logger.Warn("skipping message preview", "list", opts.List, "messageID", p.ID, "error", err)
+			return
+		}
+		p.Preview = bodyPreview(msg.Body, lines)
+	})
+
+	notFetched := skipped + int(cut.Load())
+	c.logger.Debug("month previews", "list", opts.List, "month", opts.Month, "count", len(previews), "cached_only", cachedOnly, "not_fetched", notFetched)
+	return &MonthPreviewsResult{Previews: previews, TimedOut: notFetched > 0, NotFetched: notFetched}, nil
+}
+
+// bodyPreview returns up to n lines of the original text of body, skipping
+// blank lines, quoted lines and reply attributions, and stopping at the
+// signature separator.
+func bodyPreview(body string, n int) string {
+	var kept []string
+	for _, line := range strings.Split(body, "\n") {
+		if line == "-- " || line == "--" {
+			break
+		}
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, ">") || attributionRegex.MatchString(line) {
+			continue
+		}
+		kept = append(kept, line)
+		if len(kept) == n {
+			break
+		}
+	}
+	return strings.Join(kept, "\n")
+}
@@ -0,0 +1,113 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+func TestBodyPreview(t *testing.T) {
+	body := strings.Join([]string{
+		"",
+		"On Tue, 2 Jan 2024, Alice wrote:",
+		"> Should we drop the old flag?",
+		">> Yes.",
+		"",
+		"  I agree, let's drop it.  ",
+		"",
+		"> Also the docs?",
+		"Docs next week.",
+		"Third line.",
+		"-- ",
+		"Bob",
+	}, "\n")
+
+	tests := []struct {
+		lines int
+		want  string
+	}{
+		{1, "I agree, let's drop it."},
+		{2, "I agree, let's drop it.\nDocs next week."},
+		{5, "I agree, let's drop it.\nDocs next week.\nThird line."},
+	}
+	for _, tt := range tests {
+		if got := bodyPreview(body, tt.lines); got != tt.want {
+			t.Errorf("bodyPreview(%d) = %q, want %q", tt.lines, got, tt.want)
+		}
+	}
+
+	if got := bodyPreview("> only quotes\n", 3); got != "" {
+		t.Errorf("bodyPreview of a quote-only body = %q, want empty", got)
+	}
+}
+
+func TestMonthPreviews(t *testing.T) {
+	var bodyFetches atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("m") == "" {
+			_, _ = io.WriteString(w, monthPage("git",
+				Message{ID: "2", Date: "2024-01-02", Subject: "Re: flag", Author: "B"},
+				Message{ID: "1", Date: "2024-01-01", Subject: "flag", Author: "A"},
+			))
+			return
+		}
+		bodyFetches.Add(1)
+		_, _ = io.WriteString(w, messageHTML([]string{"From: A", "Subject: flag"}, "> quoted\nFetched body "+q.Get("m")))
+	}))
+
+	// Message 1 is already cached and must not be fetched
+	c.storeMessageContent(&MessageContent{
+		Message: Message{ID: "1", List: "git", Subject: "flag", Author: "A", Date: "2024-01-01"},
+		Body:    "Cached body\nsecond line",
+		Headers: map[string]string{},
+	})
+
+	previews, err := c.MonthPreviews("git", "202401", 1, false)
+	if err != nil {
+		t.Fatalf("MonthPreviews failed: %v", err)
+	}
+	if len(previews) != 2 || previews[0].Preview != "Fetched body 2" || previews[1].Preview != "Cached body" {
+		t.Errorf("unexpected previews: %+v", previews)
+	}
+	if bodyFetches.Load() != 1 {
+		t.Errorf("expected 1 body fetch, got %d", bodyFetches.Load())
+	}
+}
+
+func TestMonthPreviewsCachedOnly(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request in cached-only mode: %s", r.URL)
+		http.NotFound(w, r)
+	}))
+
+	c.cache.SetMessages([]cache.Message{
+		{ID: "2", List: "git", Subject: "Re: flag", Author: "B", Date: "2024-01-02"},
+		{ID: "1", List: "git", Subject: "flag", Author: "A", Date: "2024-01-01"},
+	})
+	c.storeMessageContent(&MessageContent{
+		Message: Message{ID: "1", List: "git", Subject: "flag", Author: "A", Date: "2024-01-01"},
+		Body:    "Cached body\nsecond line",
+		Headers: map[string]string{},
+	})
+
+	previews, err := c.MonthPreviews("git", "202401", 2, true)
+	if err != nil {
+		t.Fatalf("MonthPreviews failed: %v", err)
+	}
+	if len(previews) != 2 || previews[0].Preview != "" || previews[1].Preview != "Cached body\nsecond line" {
+		t.Errorf("unexpected previews: %+v", previews)
+	}
+
+	empty, err := c.MonthPreviews("git", "202312", 1, true)
+	if err != nil {
+		t.Fatalf("MonthPreviews failed: %v", err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("expected no previews for an uncached month, got %+v", empty)
+	}
+}
@@ -0,0 +1,121 @@
+package marc
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Quote is one run of quoted text in a reply. Depth is the number of quote
+// markers (1 for "> ", 2 for ">> " and so on) and Attribution the
+// "On <date>, <author> wrote:" line introducing it, if there was one.
+type Quote struct {
+	Attribution string `json:"attribution,omitempty"`
+	Text        string `json:"text"`
+	Depth       int    `json:"depth"`
+}
+
+// attributionRegex matches the common reply attribution lines: "On <date>,
+// <author> wrote:", "<author> writes:", "Quoting <author>:" and similar.
+var attributionRegex = regexp.MustCompile(`(?i)^(?:.*\b(?:wrote|writes|said|says|a écrit)\s*:|quoting\s.*:)$`)
+
+// attributionTailRegex matches the second half of an attribution that mail
+// clients wrapped onto its own line.
+var attributionTailRegex = regexp.MustCompile(`(?i)^(?:wrote|writes|said)\s*:$`)
+
+// ExtractQuotes fetches a message and returns the quoted excerpts in its
+// body in order, each with its depth and attribution.
+func (c *Client) ExtractQuotes(list, messageID string) ([]Quote, error) {
+	msg, err := c.GetMessage(list, messageID)
+	if err != nil {
+		return nil, err
+	}
+	return extractQuotes(msg.Body), nil
+}
+
+type quotedLine struct {
+	depth int
+	text  string
+}
+
+// extractQuotes splits body into runs of equal quote depth. A run entered
+// from the level just above it takes its attribution from the last line of
+// that level, which is then dropped from the enclosing quote's text; a run
+// resumed after a deeper one keeps the attribution it had.
+func extractQuotes(body string) []Quote {
+	var runs [][]quotedLine
+	for _, line := range strings.Split(body, "\n") {
+		depth, text := quoteDepth(line)
+		if depth == 0 {
+			text = strings.TrimRight(line, " \t")
+		}
+		if n := len(runs); n == 0 || runs[n-1][0].depth != depth {
+			runs = append(runs, nil)
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], quotedLine{depth: depth, text: text})
+	}
+
+	texts := make([][]string, len(runs))
+	for i, run := range runs {
+		for _, l := range run {
+			texts[i] = append(texts[i], l.text)
+		}
+		texts[i] = trimBlankLines(texts[i])
+	}
+
+	attributions := make([]string, len(runs))
+	attributionAt := map[int]string{}
+	for i, run := range runs {
+		depth := run[0].depth
+		if depth == 0 {
+			clear(attributionAt)
+			continue
+		}
+		if i > 0 && runs[i-1][0].depth > depth {
+			attributions[i] = attributionAt[depth]
+			continue
+		}
+		if i > 0 && runs[i-1][0].depth == depth-1 {
+			attr, rest := takeAttribution(texts[i-1])
+			attributions[i] = attr
+			texts[i-1] = rest
+		}
+		attributionAt[depth] = attributions[i]
+		for d := range attributionAt {
+			if d > depth {
+				delete(attributionAt, d)
+			}
+		}
+	}
+
+	quotes := make([]Quote, 0)
+	for i, run := range runs {
+		if run[0].depth == 0 || len(texts[i]) == 0 {
+			continue
+		}
+		quotes = append(quotes, Quote{
+			Attribution: attributions[i],
+			Text:        strings.Join(texts[i], "\n"),
+			Depth:       run[0].depth,
+		})
+	}
+	return quotes
+}
+
+// takeAttribution splits an attribution off the end of lines, joining one
+// that was wrapped before "wrote:".
+func takeAttribution(lines []string) (string, []string) {
+	n := len(lines)
+	if n == 0 {
+		return "", lines
+	}
+
+	last := strings.TrimSpace(lines[n-1])
+	if attributionTailRegex.MatchString(last) && n > 1 {
+		joined := strings.TrimSpace(lines[n-2]) + " " + last
+		return joined, trimBlankLines(lines[:n-2])
+	}
+	if attributionRegex.MatchString(last) {
+		return last, trimBlankLines(lines[:n-1])
+	}
+	return "", lines
+}
@@ -0,0 +1,115 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractQuotes(t *testing.T) {
+	tests := []struct {
+		name string
+		body []string
+		want []Quote
+	}{
+		{
+			name: "single level",
+			body: []string{
+				"On Mon, 23 Feb 2026 at 10:00, Alice <alice@example.com> wrote:",
+				"> Should we do this?",
+				"> It looks risky.",
+				"",
+				"Yes, I think so.",
+			},
+			want: []Quote{{
+				Attribution: "On Mon, 23 Feb 2026 at 10:00, Alice <alice@example.com> wrote:",
+				Text:        "Should we do this?\nIt looks risky.",
+				Depth:       1,
+			}},
+		},
+		{
+			name: "nested",
+			body: []string{
+				"Bob writes:",
+				"> Carol wrote:",
+				">> Original point",
+				"> > continued",
+				">",
+				"> Bob's answer",
+				"",
+				"My reply.",
+			},
+			want: []Quote{
+				{Attribution: "Carol wrote:", Text: "Original point\ncontinued", Depth: 2},
+				{Attribution: "Bob writes:", Text: "Bob's answer", Depth: 1},
+			},
+		},
+		{
+			name: "wrapped attribution and interleaved replies",
+			body: []string{
+				"On Tue, Feb 24, 2026 at 9:15 AM Dave <dave@example.com>",
+				"wrote:",
+				"> first question",
+				"",
+				"first answer",
+				"",
+				"> second question",
+				"",
+				"second answer",
+			},
+			want: []Quote{
+				{Attribution: "On Tue, Feb 24, 2026 at 9:15 AM Dave <dave@example.com> wrote:", Text: "first question", Depth: 1},
+				{Text: "second question", Depth: 1},
+			},
+		},
+		{
+			name: "quoting style without attribution",
+			body: []string{
+				"Quoting Eve (2026-02-24 10:00:00):",
+				"> patch looks good",
+				"",
+				"Thanks.",
+				"",
+				"> unattributed",
+			},
+			want: []Quote{
+				{Attribution: "Quoting Eve (2026-02-24 10:00:00):", Text: "patch looks good", Depth: 1},
+				{Text: "unattributed", Depth: 1},
+			},
+		},
+		{
+			name: "no quotes",
+			body: []string{"Just a plain message."},
+			want: []Quote{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractQuotes(strings.Join(tt.body, "\n"))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("extractQuotes() =\n%#v\nwant\n%#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientExtractQuotes(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, messageHTML([]string{
+			"From: Bob <bob@example.com>",
+			"Subject: Re: question",
+		}, "Alice wrote:\n> quoted & escaped\n\nReply"))
+	}))
+
+	quotes, err := c.ExtractQuotes("git", "1")
+	if err != nil {
+		t.Fatalf("ExtractQuotes failed: %v", err)
+	}
+	want := []Quote{{Attribution: "Alice wrote:", Text: "quoted & escaped", Depth: 1}}
+	if !reflect.DeepEqual(quotes, want) {
+		t.Errorf("ExtractQuotes() = %#v, want %#v", quotes, want)
+	}
+}
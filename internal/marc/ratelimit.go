@@ -0,0 +1,170 @@
+package marc
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// throttleCooldown is how long each slowdown lasts before the rate
+	// recovers one step.
+	throttleCooldown = time.Minute
+	// maxThrottleSteps caps the slowdown at 1/32 of the configured rate.
+	maxThrottleSteps = 5
+	// throttleBaseInterval is the spacing slowed down from when no rate
+	// limit is configured, so the first step allows 1 request per second.
+	throttleBaseInterval = 500 * time.Millisecond
+	// maxThrottleWait caps how long a server hint can pause all requests.
+	maxThrottleWait = time.Minute
+)
+
+// rateLimiter spaces requests evenly at no more than perSecond requests per
+// second, slowed down further while marc.info signals throttling. A nil
+// *rateLimiter does not limit.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+
+	// steps is the number of rate halvings in effect since throttledAt;
+	// one is undone per throttleCooldown.
+	steps       int
+	throttledAt time.Time
+	now         func() time.Time
+}
+
+// newRateLimiter returns a limiter for perSecond requests per second, or an
+// unlimited one that only slows down when throttled if perSecond <= 0.
+func newRateLimiter(perSecond float64) *rateLimiter {
+	l := &rateLimiter{now: time.Now}
+	if perSecond > 0 {
+		l.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return l
+}
+
+// retuned returns a limiter for perSecond that keeps l's throttling
+// slowdown and any hold marc.info asked for, so changing the rate cannot
+// lift them early.
+func (l *rateLimiter) retuned(perSecond float64) *rateLimiter {
+	next := newRateLimiter(perSecond)
+	if l == nil {
+		return next
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	next.now = l.now
+	next.next = l.next
+	next.steps = l.steps
+	next.throttledAt = l.throttledAt
+	return next
+}
+
+// Wait blocks until the caller may send its request, and returns ctx's
+// error if ctx ends first. The slot stays taken either way.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.currentInterval(now))
+	l.mu.Unlock()
+
+	return sleepContext(ctx, wait)
+}
+
+// sleepContext pauses for d, returning ctx's error early once ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Throttle halves the request rate for a cooldown window and, when
+// marc.info said how long to back off, holds every request for that long.
+func (l *rateLimiter) Throttle(wait time.Duration) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.recover(now)
+	l.steps = min(l.steps+1, maxThrottleSteps)
+	l.throttledAt = now
+
+	if resume := now.Add(min(wait, maxThrottleWait)); resume.After(l.next) {
+		l.next = resume
+	}
+}
+
+// currentInterval returns the spacing in effect at now. l.mu must be held.
+func (l *rateLimiter) currentInterval(now time.Time) time.Duration {
+	l.recover(now)
+	if l.steps == 0 {
+		return l.interval
+	}
+	base := l.interval
+	if base == 0 {
+		base = throttleBaseInterval
+	}
+	return base << l.steps
+}
+
+// recover undoes one halving per full cooldown since the last throttle.
+// l.mu must be held.
+func (l *rateLimiter) recover(now time.Time) {
+	for l.steps > 0 && now.Sub(l.throttledAt) >= throttleCooldown {
+		l.steps--
+		l.throttledAt = l.throttledAt.Add(throttleCooldown)
+	}
+}
+
+// waitHintRegex matches marc.info's "please wait N seconds" notices.
+var waitHintRegex = regexp.MustCompile(`(?i)wait\s+(\d+)\s+sec`)
+
+// throttleHint reports whether a response signals that marc.info is
+// throttling us, and how long it asked us to wait. 429 and 503 responses
+// count, as do pages asking to wait that contain no <pre> (and so no list
+// or message content whose text could match by accident).
+func throttleHint(status int, header http.Header, body string) (time.Duration, bool) {
+	var wait time.Duration
+	if secs, err := strconv.Atoi(strings.TrimSpace(header.Get("Retry-After"))); err == nil && secs > 0 {
+		wait = time.Duration(secs) * time.Second
+	}
+
+	hinted := false
+	if !strings.Contains(body, "<pre") {
+		if m := waitHintRegex.FindStringSubmatch(body); m != nil {
+			hinted = true
+			if secs, err := strconv.Atoi(m[1]); err == nil && wait == 0 {
+				wait = time.Duration(secs) * time.Second
+			}
+		}
+	}
+
+	throttled := status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable || hinted
+	return wait, throttled
+}
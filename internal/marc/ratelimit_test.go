@@ -0,0 +1,241 @@
+package marc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterSpacesRequests(t *testing.T) {
+	l := newRateLimiter(100) // one request per 10ms
+
+	start := time.Now()
+	for range 5 {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait failed: %v", err)
+		}
+	}
+
+	// The first request goes immediately, the other four wait their turn
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("5 requests took %v, want at least 40ms", elapsed)
+	}
+
+	var unlimited *rateLimiter
+	_ = unlimited.Wait(context.Background()) // must not block or panic
+
+	// A cancelled wait returns at once instead of sleeping out the slot
+	slow := newRateLimiter(1)
+	_ = slow.Wait(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start = time.Now()
+	if err := slow.Wait(ctx); err != context.Canceled {
+		t.Errorf("Wait on a cancelled context = %v, want %v", err, context.Canceled)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("cancelled Wait took %v", elapsed)
+	}
+}
+
+func TestRateLimiterThrottle(t *testing.T) {
+	clock := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	newLimiter := func(perSecond float64) *rateLimiter {
+		l := newRateLimiter(perSecond)
+		l.now = func() time.Time { return clock }
+		return l
+	}
+
+	t.Run("halves the rate and recovers step by step", func(t *testing.T) {
+		l := newLimiter(10) // 100ms spacing
+
+		l.Throttle(0)
+		l.Throttle(0)
+		if got := l.currentInterval(clock); got != 400*time.Millisecond {
+			t.Errorf("after two throttles interval = %v, want 400ms", got)
+		}
+
+		clock = clock.Add(throttleCooldown)
+		if got := l.currentInterval(clock); got != 200*time.Millisecond {
+			t.Errorf("after one cooldown interval = %v, want 200ms", got)
+		}
+
+		clock = clock.Add(throttleCooldown)
+		if got := l.currentInterval(clock); got != 100*time.Millisecond {
+			t.Errorf("after two cooldowns interval = %v, want 100ms", got)
+		}
+	})
+
+	t.Run("slows an unlimited client and caps the slowdown", func(t *testing.T) {
+		l := newLimiter(0)
+		if got := l.currentInterval(clock); got != 0 {
+			t.Errorf("unthrottled interval = %v, want 0", got)
+		}
+
+		l.Throttle(0)
+		if got := l.currentInterval(clock); got != time.Second {
+			t.Errorf("throttled interval = %v, want 1s", got)
+		}
+
+		for range 10 {
+			l.Throttle(0)
+		}
+		if got := l.currentInterval(clock); got != throttleBaseInterval<<maxThrottleSteps {
+			t.Errorf("interval = %v, want cap %v", got, throttleBaseInterval<<maxThrottleSteps)
+		}
+	})
+
+	t.Run("holds requests for the hinted wait", func(t *testing.T) {
+		l := newLimiter(0)
+		l.Throttle(30 * time.Second)
+		if want := clock.Add(30 * time.Second); !l.next.Equal(want) {
+			t.Errorf("next = %v, want %v", l.next, want)
+		}
+
+		l.Throttle(time.Hour)
+		if want := clock.Add(maxThrottleWait); !l.next.Equal(want) {
+			t.Errorf("next = %v, want capped %v", l.next, want)
+		}
+	})
+}
+
+func TestThrottleHint(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		header    http.Header
+		body      string
+		wantWait  time.Duration
+		throttled bool
+	}{
+		{"ok page", http.StatusOK, nil, "<pre>messages</pre>", 0, false},
+		{"too many requests", http.StatusTooManyRequests, nil, "", 0, true},
+		{"retry-after", http.StatusServiceUnavailable, http.Header{"Retry-After": {"12"}}, "", 12 * time.Second, true},
+		{"wait notice", http.StatusOK, nil, "<p>Please wait 30 seconds before retrying.</p>", 30 * time.Second, true},
+		{"wait text inside a message", http.StatusOK, nil, "<pre>please wait 5 seconds</pre>", 0, false},
+		{"not found", http.StatusNotFound, nil, "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := tt.header
+			if header == nil {
+				header = http.Header{}
+			}
+			wait, throttled := throttleHint(tt.status, header, tt.body)
+			if wait != tt.wantWait || throttled != tt.throttled {
+				t.Errorf("throttleHint() = %v, %t; want %v, %t", wait, throttled, tt.wantWait, tt.throttled)
+			}
+		})
+	}
+}
+
+func TestFetchThrottled(t *testing.T) {
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			_, _ = io.WriteString(w, "<html><body>Too fast, please wait 0 seconds</body></html>")
+			return
+		}
+		_, _ = io.WriteString(w, "<html><body><pre>ok</pre></body></html>")
+	}))
+
+	raw, err := client.fetchRaw("?l=git")
+	if err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if raw != "<html><body><pre>ok</pre></body></html>" || requests != 2 {
+		t.Errorf("expected the throttle page to be retried, got %q after %d requests", raw, requests)
+	}
+
+	_, limiter := client.conn.get()
+	limiter.mu.Lock()
+	steps := limiter.steps
+	limiter.mu.Unlock()
+	if steps != 1 {
+		t.Errorf("expected the client to slow down one step, got %d", steps)
+	}
+}
+
+func TestReconfigure(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+
+	t.Run("rejects invalid settings", func(t *testing.T) {
+		if err := client.Reconfigure(Config{Timeout: time.Second}); err == nil {
+			t.Error("expected error for timeout below minimum")
+		}
+		if err := client.Reconfigure(Config{Timeout: time.Minute, RateLimit: -1}); err == nil {
+			t.Error("expected error for negative rate limit")
+		}
+	})
+
+	t.Run("applies settings", func(t *testing.T) {
+		if err := client.Reconfigure(Config{Timeout: 30 * time.Second, RateLimit: 1000}); err != nil {
+			t.Fatalf("Reconfigure failed: %v", err)
+		}
+		if cfg := client.Config(); cfg.Timeout != 30*time.Second || cfg.RateLimit != 1000 {
+			t.Errorf("Config() = %+v", cfg)
+		}
+	})
+
+	t.Run("keeps the throttling slowdown", func(t *testing.T) {
+		client.conn.limiter.Throttle(time.Minute)
+		if err := client.Reconfigure(Config{Timeout: 30 * time.Second, RateLimit: 1000}); err != nil {
+			t.Fatalf("Reconfigure failed: %v", err)
+		}
+
+		l := client.conn.limiter
+		if l.steps != 1 {
+			t.Errorf("steps after reconfigure = %d, want 1", l.steps)
+		}
+		if got := l.currentInterval(l.now()); got != 2*time.Millisecond {
+			t.Errorf("interval after reconfigure = %v, want 2ms", got)
+		}
+		if wait := time.Until(l.next); wait < 50*time.Second {
+			t.Errorf("hinted hold dropped by reconfigure, %v left", wait)
+		}
+
+		// Lift the throttle so the remaining subtests are not held up
+		client.conn.limiter = newRateLimiter(1000)
+	})
+
+	// Run with -race: swapping settings must not race with fetches
+	t.Run("concurrent with fetches", func(t *testing.T) {
+		var wg sync.WaitGroup
+		done := make(chan struct{})
+
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 20 {
+					if _, err := client.fetchRaw("?l=git"); err != nil {
+						t.Errorf("fetch failed: %v", err)
+						return
+					}
+				}
+			}()
+		}
+
+		go func() {
+			defer close(done)
+			for i := range 50 {
+				cfg := Config{Timeout: time.Duration(10+i) * time.Second, RateLimit: float64(i % 2 * 5000)}
+				if err := client.Reconfigure(cfg); err != nil {
+					t.Errorf("Reconfigure failed: %v", err)
+					return
+				}
+				_ = client.Config()
+			}
+		}()
+
+		wg.Wait()
+		<-done
+	})
+}
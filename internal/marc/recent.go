@@ -0,0 +1,71 @@
+package marc
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// recentWorkers bounds how many lists RecentAcrossLists lists at once.
+const recentWorkers = 4
+
+// RecentMessages is the merged recent activity of several lists.
+type RecentMessages struct {
+	Messages []Message `json:"messages"`
+	// Failed names the lists that could not be listed and were skipped.
+	Failed []string `json:"failed,omitempty"`
+}
+
+// RecentAcrossLists returns the n most recent messages across lists, newest
+// first. Each list's current month is listed (from the cache while fresh),
+// along with the previous month when the current one has fewer than n
+// messages. Messages from the same day keep the order of lists, then of
+// the listing. Lists that fail are skipped and reported in Failed.
+func (c *Client) RecentAcrossLists(lists []string, n int) (*RecentMessages, error) {
+	now := time.Now()
+	months := []string{now.Format("200601"), now.AddDate(0, 0, -now.Day()).Format("200601")}
+
+	perList := make([][]Message, len(lists))
+	failed := make([]bool, len(lists))
+
+	var mu sync.Mutex
+	forEachLimited(recentWorkers, len(lists), func(i int) {
+		var messages []Message
+		for _, month := range months {
+			page, err := c.ListMessages(lists[i], month)
+			if err != nil {
+				c.logger.Warn("skipping list for recent messages", "list", lists[i], "month", month, "error", err)
+				mu.Lock()
+				failed[i] = true
+				mu.Unlock()
+				return
+			}
+			messages = append(messages, page...)
+			if len(messages) >= n {
+				break
+			}
+		}
+		mu.Lock()
+		perList[i] = messages
+		mu.Unlock()
+	})
+
+	result := &RecentMessages{Messages: make([]Message, 0)}
+	for i, messages := range perList {
+		if failed[i] {
+			result.Failed = append(result.Failed, lists[i])
+			continue
+		}
+		result.Messages = append(result.Messages, messages...)
+	}
+
+	sort.SliceStable(result.Messages, func(i, j int) bool {
+		return result.Messages[i].Date > result.Messages[j].Date
+	})
+	if len(result.Messages) > n {
+		result.Messages = result.Messages[:n]
+	}
+
+	c.logger.Debug("recent messages", "lists", len(lists), "failed", len(result.Failed), "count", len(result.Messages))
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package marc
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRecentAcrossLists(t *testing.T) {
+	now := time.Now()
+	current := now.Format("200601")
+	day := func(d int) string { return fmt.Sprintf("%s%02d", now.Format("2006-01-"), d) }
+
+	pages := map[string]string{
+		"git" + current: monthPage("git",
+			Message{ID: "12", Date: day(4), Subject: "git newest", Author: "A"},
+			Message{ID: "11", Date: day(2), Subject: "git older", Author: "A"},
+		),
+		"hg" + current: monthPage("hg",
+			Message{ID: "22", Date: day(5), Subject: "hg newest", Author: "B"},
+			Message{ID: "21", Date: day(1), Subject: "hg oldest", Author: "B"},
+		),
+		"svn" + current: monthPage("svn",
+			Message{ID: "33", Date: day(4), Subject: "svn same day", Author: "C"},
+			Message{ID: "32", Date: day(3), Subject: "svn middle", Author: "C"},
+			Message{ID: "31", Date: day(1), Subject: "svn oldest", Author: "C"},
+		),
+	}
+
+	var previousMonthFetches atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("l") == "broken" {
+			http.NotFound(w, r)
+			return
+		}
+		if q.Get("b") != current {
+			previousMonthFetches.Add(1)
+			_, _ = io.WriteString(w, monthPage(q.Get("l"),
+				Message{ID: fmt.Sprint(900 + len(q.Get("l"))), Date: now.AddDate(0, 0, -now.Day()).Format("2006-01-02"), Subject: "last month", Author: "D"},
+			))
+			return
+		}
+		_, _ = io.WriteString(w, pages[q.Get("l")+q.Get("b")])
+	}))
+
+	result, err := c.RecentAcrossLists([]string{"git", "broken", "hg", "svn"}, 3)
+	if err != nil {
+		t.Fatalf("RecentAcrossLists failed: %v", err)
+	}
+
+	var ids []string
+	for _, m := range result.Messages {
+		ids = append(ids, m.ID)
+	}
+	// Same-day messages keep the order of the lists argument
+	if want := []string{"22", "12", "33"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("messages = %v, want %v", ids, want)
+	}
+	if want := []string{"broken"}; !reflect.DeepEqual(result.Failed, want) {
+		t.Errorf("failed = %v, want %v", result.Failed, want)
+	}
+	// git and hg had fewer than 3 messages this month
+	if previousMonthFetches.Load() != 2 {
+		t.Errorf("expected 2 previous-month fetches, got %d", previousMonthFetches.Load())
+	}
+
+	// A second call is answered from the per-list listing cache
+	previousMonthFetches.Store(0)
+	if _, err := c.RecentAcrossLists([]string{"git", "hg", "svn"}, 3); err != nil {
+		t.Fatalf("RecentAcrossLists failed: %v", err)
+	}
+	if previousMonthFetches.Load() != 0 {
+		t.Errorf("expected cached listings, got %d fetches", previousMonthFetches.Load())
+	}
+}
@@ -0,0 +1,58 @@
+package marc
+
+import (
+	"net/url"
+	"sync"
+)
+
+// listRedirects remembers lists that marc.info redirected to another name.
+// It is shared by pointer so copies of a Client agree.
+type listRedirects struct {
+	mu    sync.Mutex
+	names map[string]string
+}
+
+func newListRedirects() *listRedirects {
+	return &listRedirects{names: make(map[string]string)}
+}
+
+// fetchList is fetchRaw for a page of list. When marc.info redirected to a
+// URL naming another list (a renamed list), that name is returned as the
+// canonical one and remembered, so normalizeList sends later requests
+// straight to it and results are cached under it. On error list is
+// returned as is.
+func (c *Client) fetchList(path, list string) (raw, canonical string, err error) {
+	raw, finalURL, err := c.fetchWithRetry(path)
+	if err != nil {
+		return "", list, err
+	}
+	return raw, c.canonicalList(list, finalURL), nil
+}
+
+// canonicalList returns the list named by the l= parameter of finalURL, or
+// list when the URL does not name one.
+func (c *Client) canonicalList(list string, finalURL *url.URL) string {
+	if finalURL == nil {
+		return list
+	}
+	canonical := finalURL.Query().Get("l")
+	if canonical == "" || canonical == list {
+		return list
+	}
+
+	c.listRedirects.mu.Lock()
+	defer c.listRedirects.mu.Unlock()
+	if c.listRedirects.names[list] != canonical {
+		c.logger.Info("marc.info redirected list", "requested", list, "list", canonical)
+		c.listRedirects.names[list] = canonical
+	}
+	return canonical
+}
+
+// redirectedList returns the name marc.info redirected list to earlier.
+func (c *Client) redirectedList(list string) (string, bool) {
+	c.listRedirects.mu.Lock()
+	defer c.listRedirects.mu.Unlock()
+	canonical, ok := c.listRedirects.names[list]
+	return canonical, ok
+}
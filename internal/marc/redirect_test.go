@@ -0,0 +1,62 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListRedirect(t *testing.T) {
+	var requested []string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		requested = append(requested, q.Get("l"))
+		if q.Get("l") == "old-git" {
+			q.Set("l", "git")
+			http.Redirect(w, r, "/?"+q.Encode(), http.StatusMovedPermanently)
+			return
+		}
+		if q.Get("m") != "" {
+			_, _ = io.WriteString(w, messageHTML([]string{"From: A", "Subject: renamed"}, "body"))
+			return
+		}
+		_, _ = io.WriteString(w, monthPage("git", Message{ID: "1", Date: "2024-01-02", Subject: "renamed", Author: "A"}))
+	}))
+
+	page, err := c.ListMessagesPage(ListMessagesOptions{List: "old-git", Month: "202401"})
+	if err != nil {
+		t.Fatalf("ListMessagesPage failed: %v", err)
+	}
+	if page.List != "git" || page.RequestedList != "old-git" {
+		t.Errorf("list = %q, requested = %q, want git and old-git", page.List, page.RequestedList)
+	}
+	if len(page.Messages) != 1 || page.Messages[0].List != "git" {
+		t.Fatalf("expected the message parsed under git, got %+v", page.Messages)
+	}
+
+	// The listing was cached under the canonical name, and later requests
+	// go there directly
+	page, err = c.ListMessagesPage(ListMessagesOptions{List: "old-git", Month: "202401"})
+	if err != nil {
+		t.Fatalf("ListMessagesPage failed: %v", err)
+	}
+	if page.List != "git" || page.RequestedList != "old-git" || len(page.Messages) != 1 {
+		t.Errorf("unexpected cached page: %+v", page)
+	}
+
+	msg, err := c.GetMessage("old-git", "1")
+	if err != nil {
+		t.Fatalf("GetMessage failed: %v", err)
+	}
+	if msg.List != "git" {
+		t.Errorf("message list = %q, want git", msg.List)
+	}
+	if _, ok := c.cache.GetMessageContent("git", "1"); !ok {
+		t.Error("expected the message cached under git")
+	}
+
+	if want := []string{"old-git", "git", "git"}; !reflect.DeepEqual(requested, want) {
+		t.Errorf("requested lists = %v, want %v", requested, want)
+	}
+}
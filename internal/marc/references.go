@@ -0,0 +1,119 @@
+package marc
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// maxReferencedMessages bounds how many references FindReferencedMessages
+// resolves, since each may take a lookup and a fetch.
+const maxReferencedMessages = 20
+
+// Match a marc.info message URL quoted in a message body
+var marcURLRegex = regexp.MustCompile(`https?://(?:[\w-]+\.)*marc\.info/?\?[^\s<>"'()\[\]]+`)
+
+// FindReferencedMessages scans a message body for marc.info message URLs
+// and <local@domain> Message-IDs and returns the messages they point to,
+// in order of first mention. References that cannot be resolved or
+// fetched, such as bracketed email addresses, are logged and skipped, as
+// are references to the message itself.
+func (c *Client) FindReferencedMessages(list, messageID string) ([]Message, error) {
+	list = c.normalizeList(list)
+
+	messageID, err := NormalizeMessageID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := c.GetMessage(list, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{msg.List + "/" + msg.ID: true}
+	ownID := strings.TrimSpace(headerValue(msg.Headers, "Message-ID"))
+
+	refs := bodyReferences(msg.Body)
+	if len(refs) > maxReferencedMessages {
+		c.logger.Debug("truncating references", "list", list, "messageID", messageID, "count", len(refs))
+		refs = refs[:maxReferencedMessages]
+	}
+
+	messages := make([]Message, 0)
+	for _, ref := range refs {
+		if ref == ownID {
+			continue
+		}
+
+		refList, refID, err := c.resolveReference(ref, list)
+		if err != nil {
+			c.logger.Debug("skipping unresolved reference", "ref", ref, "error", err)
+			continue
+		}
+		key := refList + "/" + refID
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		referenced, err := c.GetMessage(refList, refID)
+		if err != nil {
+			c.logger.Debug("skipping unfetchable reference", "ref", ref, "error", err)
+			continue
+		}
+		messages = append(messages, referenced.Message)
+	}
+
+	c.logger.Debug("found referenced messages", "list", list, "messageID", messageID, "refs", len(refs), "count", len(messages))
+	return messages, nil
+}
+
+// bodyReferences returns the marc.info URLs and Message-IDs in body, in
+// order and without duplicates.
+func bodyReferences(body string) []string {
+	type match struct {
+		pos int
+		ref string
+	}
+	var matches []match
+	for _, loc := range marcURLRegex.FindAllStringIndex(body, -1) {
+		ref := strings.TrimRight(body[loc[0]:loc[1]], ".,;:!?")
+		matches = append(matches, match{loc[0], ref})
+	}
+	for _, loc := range messageIDRegex.FindAllStringIndex(body, -1) {
+		if ref := body[loc[0]:loc[1]]; strings.Contains(ref, "@") {
+			matches = append(matches, match{loc[0], ref})
+		}
+	}
+
+	// Merge the two scans back into body order
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].pos < matches[j].pos })
+
+	seen := make(map[string]bool)
+	refs := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if !seen[m.ref] {
+			seen[m.ref] = true
+			refs = append(refs, m.ref)
+		}
+	}
+	return refs
+}
+
+// resolveReference maps a marc.info URL or a Message-ID to a list and marc
+// ID. URLs without a list fall back to list.
+func (c *Client) resolveReference(ref, list string) (string, string, error) {
+	if !strings.HasPrefix(ref, "<") {
+		id, err := NormalizeMessageID(ref)
+		if err != nil {
+			return "", "", err
+		}
+		if u, err := url.Parse(ref); err == nil && u.Query().Get("l") != "" {
+			list = c.normalizeList(u.Query().Get("l"))
+		}
+		return list, id, nil
+	}
+	return c.ResolveMessageID(ref)
+}
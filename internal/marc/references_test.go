@@ -0,0 +1,89 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFindReferencedMessages(t *testing.T) {
+	body := "The earlier attempt is at https://marc.info/?l=linux-mm&m=200&w=2.\n" +
+		"Alice <alice@example.com> fixed it in <fix@example.com>, see also\n" +
+		"https://marc.info/?l=linux-mm&m=200&w=2 and my own <self@example.com>.\n" +
+		"A dead link: https://marc.info/?l=git&m=999&w=2"
+	pages := map[string]string{
+		"git/100": messageHTML([]string{
+			"Subject: [PATCH v2] frob",
+			"From: Bob <bob@example.com>",
+			"Message-ID: <self@example.com>",
+		}, body),
+		"linux-mm/200": messageHTML([]string{
+			"Subject: [PATCH] frob",
+			"From: Bob <bob@example.com>",
+			"Date: Mon, 2 Feb 2026 10:00:00 +0000",
+		}, "v1"),
+		"git/300": messageHTML([]string{
+			"Subject: [PATCH] fix frob",
+			"From: Alice <alice@example.com>",
+			"Date: Tue, 3 Feb 2026 10:00:00 +0000",
+		}, "fix"),
+	}
+
+	var lookups []string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if msgID := q.Get("i"); msgID != "" {
+			lookups = append(lookups, msgID)
+			if msgID == "fix@example.com" {
+				http.Redirect(w, r, "/?l=git&m=300&w=2", http.StatusFound)
+				return
+			}
+			_, _ = io.WriteString(w, "<html><body>No hits found</body></html>")
+			return
+		}
+		page, ok := pages[q.Get("l")+"/"+q.Get("m")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, page)
+	}))
+
+	messages, err := c.FindReferencedMessages("git", "100")
+	if err != nil {
+		t.Fatalf("FindReferencedMessages failed: %v", err)
+	}
+
+	var got []string
+	for _, m := range messages {
+		got = append(got, m.List+"/"+m.ID+" "+m.Subject)
+	}
+	want := []string{
+		"linux-mm/200 [PATCH] frob",
+		"git/300 [PATCH] fix frob",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("referenced messages = %q, want %q", got, want)
+	}
+
+	// The message's own Message-ID is never looked up
+	if wantLookups := []string{"alice@example.com", "fix@example.com"}; !reflect.DeepEqual(lookups, wantLookups) {
+		t.Errorf("looked up %q, want %q", lookups, wantLookups)
+	}
+}
+
+func TestBodyReferences(t *testing.T) {
+	body := "see <a@example.com> and https://marc.info/?l=git&m=1&w=2, then (https://marc.info/?m=2)\n" +
+		"> <a@example.com> again, not an id: <not-an-id> http://example.com/?l=git&m=3"
+
+	got := bodyReferences(body)
+	want := []string{
+		"<a@example.com>",
+		"https://marc.info/?l=git&m=1&w=2",
+		"https://marc.info/?m=2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("bodyReferences() = %q, want %q", got, want)
+	}
+}
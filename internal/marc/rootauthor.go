@@ -0,0 +1,92 @@
+package marc
+
+import (
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// marc.info obfuscates addresses as "user () example ! com"
+var obfuscatedAtRegex = regexp.MustCompile(`\s*\(\)\s*`)
+var obfuscatedDotRegex = regexp.MustCompile(`\s*!\s*`)
+
+// AuthorMessagesInThread walks the thread from rootMessageID (see GetThread)
+// and returns only the messages sent by the root message's author, oldest
+// first. Messages without a parseable date follow in thread order. Authors
+// are matched by address, so display-name variations of the same sender
+// still match.
+func (c *Client) AuthorMessagesInThread(list, rootMessageID string) ([]MessageContent, error) {
+	rootMessageID, err := NormalizeMessageID(rootMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := c.GetThread(list, rootMessageID)
+	if err != nil {
+		return nil, err
+	}
+
+	type datedMessage struct {
+		msg  MessageContent
+		date time.Time
+	}
+
+	// Thread order follows replies, so dated messages are sorted by date
+	// and undated ones keep their thread order after them
+	author := authorKey(messageAuthor(thread[0]))
+	var dated []datedMessage
+	var undated []MessageContent
+	for _, msg := range thread {
+		if authorKey(messageAuthor(msg)) != author {
+			continue
+		}
+		if date, err := mail.ParseDate(msg.Date); err == nil {
+			dated = append(dated, datedMessage{msg, date})
+		} else {
+			undated = append(undated, msg)
+		}
+	}
+	slices.SortStableFunc(dated, func(a, b datedMessage) int {
+		return a.date.Compare(b.date)
+	})
+
+	messages := make([]MessageContent, 0, len(dated)+len(undated))
+	for _, d := range dated {
+		messages = append(messages, d.msg)
+	}
+	messages = append(messages, undated...)
+
+	c.logger.Debug("root author messages", "list", list, "root", rootMessageID, "author", author, "count", len(messages), "thread", len(thread))
+	return messages, nil
+}
+
+// messageAuthor prefers the From header over the listing's author column.
+func messageAuthor(msg MessageContent) string {
+	if from := headerValue(msg.Headers, "From"); from != "" {
+		return from
+	}
+	return msg.Author
+}
+
+// authorKey normalizes an author for comparison: the lowercased address
+// when one can be found, undoing marc.info's obfuscation, otherwise the
+// lowercased name with whitespace collapsed.
+func authorKey(author string) string {
+	author = strings.TrimSpace(author)
+	if addr, err := mail.ParseAddress(deobfuscateAddress(author)); err == nil {
+		return strings.ToLower(addr.Address)
+	}
+	return strings.ToLower(strings.Join(strings.Fields(author), " "))
+}
+
+// deobfuscateAddress rewrites "user () example ! com" to
+// "user@example.com", leaving other text alone.
+func deobfuscateAddress(s string) string {
+	if !strings.Contains(s, "()") {
+		return s
+	}
+	s = obfuscatedAtRegex.ReplaceAllString(s, "@")
+	return obfuscatedDotRegex.ReplaceAllString(s, ".")
+}
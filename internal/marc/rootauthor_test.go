@@ -0,0 +1,88 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestAuthorMessagesInThread(t *testing.T) {
+	pages := map[string]string{
+		"10": threadPage("11", []string{
+			"Subject: [RFC] new frobnicator",
+			"From: Alice Smith <alice () example ! com>",
+			"Date: Mon, 2 Feb 2026 10:00:00 +0000",
+		}, "Proposal v1."),
+		"11": threadPage("15", []string{
+			"Subject: Re: [RFC] new frobnicator",
+			"From: Bob <bob@example.com>",
+			"Date: Mon, 2 Feb 2026 11:00:00 +0000",
+		}, "Why not reuse the old one?"),
+		// Undated messages go last, wherever they sit in the thread
+		"15": threadPage("12", []string{
+			"Subject: Re: [RFC] new frobnicator",
+			"From: Alice Smith <alice@example.com>",
+			"Date: sometime",
+		}, "Proposal notes."),
+		// Thread order is by reply, so a later follow-up can come first
+		"12": threadPage("13", []string{
+			"Subject: Re: [RFC] new frobnicator",
+			"From: \"Smith, Alice\" <Alice@Example.com>",
+			"Date: Wed, 4 Feb 2026 09:00:00 +0000",
+		}, "Proposal v3."),
+		"13": threadPage("14", []string{
+			"Subject: Re: [RFC] new frobnicator",
+			"From: alice@example.com",
+			"Date: Tue, 3 Feb 2026 09:00:00 +0000",
+		}, "Proposal v2."),
+		"14": threadPage("", []string{
+			"Subject: Re: [RFC] new frobnicator",
+			"From: Alice Smith <alice@example.org>",
+			"Date: Thu, 5 Feb 2026 09:00:00 +0000",
+		}, "Someone else with the same name."),
+	}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, pages[r.URL.Query().Get("m")])
+	}))
+
+	messages, err := c.AuthorMessagesInThread("git", "10")
+	if err != nil {
+		t.Fatalf("AuthorMessagesInThread failed: %v", err)
+	}
+
+	var bodies []string
+	for _, m := range messages {
+		bodies = append(bodies, m.Body)
+	}
+	want := []string{"Proposal v1.", "Proposal v2.", "Proposal v3.", "Proposal notes."}
+	if len(bodies) != len(want) {
+		t.Fatalf("got bodies %q, want %q", bodies, want)
+	}
+	for i := range want {
+		if bodies[i] != want[i] {
+			t.Errorf("message %d body = %q, want %q", i, bodies[i], want[i])
+		}
+	}
+
+	if _, err := c.AuthorMessagesInThread("git", "not-an-id"); err == nil {
+		t.Error("expected an error for an invalid message ID")
+	}
+}
+
+func TestAuthorKey(t *testing.T) {
+	tests := []struct {
+		author string
+		want   string
+	}{
+		{"Alice <Alice@Example.com>", "alice@example.com"},
+		{"alice@example.com", "alice@example.com"},
+		{"Alice Smith <alice () example ! com>", "alice@example.com"},
+		{"\"Smith, Alice\" <alice@example.com>", "alice@example.com"},
+		{"  Alice   Smith ", "alice smith"},
+	}
+	for _, tt := range tests {
+		if got := authorKey(tt.author); got != tt.want {
+			t.Errorf("authorKey(%q) = %q, want %q", tt.author, got, tt.want)
+		}
+	}
+}
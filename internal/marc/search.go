@@ -0,0 +1,162 @@
+package marc
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+// SearchMulti runs Search once per search type and returns the union of the
+// results, de-duplicated by message ID. Messages keep the order of the first
+// search that found them, so subject hits come before body-only hits for
+// []string{"s", "b"}. Duplicate types are searched once.
+func (c *Client) SearchMulti(list, query string, searchTypes []string) ([]Message, error) {
+	seen := make(map[string]bool)
+	merged := make([]Message, 0)
+	searched := make(map[string]bool)
+
+	for _, searchType := range searchTypes {
+		if searched[searchType] {
+			continue
+		}
+		searched[searchType] = true
+
+		messages, err := c.Search(list, query, searchType)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range messages {
+			if !seen[m.ID] {
+				seen[m.ID] = true
+				merged = append(merged, m)
+			}
+		}
+	}
+
+	c.logger.Debug("merged searches", "types", searchTypes, "count", len(merged))
+	return merged, nil
+}
+
+// prefetchWorkers bounds how many messages SearchAndCache fetches at once.
+const prefetchWorkers = 4
+
+// SearchAndCacheResult is a live search whose hits were fetched into the
+// cache.
+type SearchAndCacheResult struct {
+	Messages []Message `json:"messages"`
+	// Cached counts hits fetched by this call, AlreadyCached those that
+	// were fresh in the cache and Failed those that could not be fetched.
+	Cached        int `json:"cached"`
+	AlreadyCached int `json:"already_cached"`
+	Failed        int `json:"failed"`
+	// TimedOut is set when the context ended before every hit was
+	// fetched; NotFetched counts the hits that were not attempted.
+	TimedOut   bool `json:"timed_out,omitempty"`
+	NotFetched int  `json:"not_fetched,omitempty"`
+}
+
+// SearchAndCache runs a live marc.info search and fetches the full content
+// of every hit into the cache, so cache searches cover them afterwards.
+// Hits already cached and fresh are not fetched again. Fetch failures are
+// logged and counted rather than failing the search.
+func (c *Client) SearchAndCache(list, query, searchType string) (*SearchAndCacheResult, error) {
+	return c.SearchAndCacheContext(context.Background(), list, query, searchType)
+}
+
+// SearchAndCacheContext is SearchAndCache that stops fetching hits once ctx
+// is done and returns what was cached so far, with TimedOut set. Fetches
+// still running are cancelled and counted as not fetched; a search cut off
+// itself returns no messages.
+func (c *Client) SearchAndCacheContext(ctx context.Context, list, query, searchType string) (*SearchAndCacheResult, error) {
+	c = c.WithContext(ctx)
+	messages, err := c.Search(list, query, searchType)
+	if err != nil && ctx.Err() != nil {
+		c.logger.Debug("search cut off", "list", list, "query", query, "error", err)
+		return &SearchAndCacheResult{Messages: []Message{}, TimedOut: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchAndCacheResult{Messages: messages}
+
+	var toFetch []Message
+	for _, m := range messages {
+		if _, ok := c.cache.GetMessageContent(m.List, m.ID); ok {
+			result.AlreadyCached++
+			continue
+		}
+		toFetch = append(toFetch, m)
+	}
+
+	c.logger.Debug("caching search hits", "list", list, "query", query, "hits", len(messages), "fetch", len(toFetch))
+
+	var cached, failed, cut atomic.Int32
+	skipped := forEachLimitedContext(ctx, prefetchWorkers, len(toFetch), func(i int) {
+		m := toFetch[i]
+		if _, err := c.GetMessage(m.List, m.ID); err != nil {
+			if ctx.Err() != nil {
+				cut.Add(1)
+				return
+			}
+			c.logger.Warn("failed to cache search hit", "list", m.List, "messageID", m.ID, "error", err)
+			failed.Add(1)
+			return
+		}
+		cached.Add(1)
+	})
+
+	result.Cached = int(cached.Load())
+	result.Failed = int(failed.Load())
+	if notFetched := skipped + int(cut.Load()); notFetched > 0 {
+		result.TimedOut, result.NotFetched = true, notFetched
+		c.logger.Debug("search caching stopped early", "list", list, "query", query, "not_fetched", notFetched, "error", ctx.Err())
+	}
+	return result, nil
+}
+
+// SearchCount returns how many messages a live marc.info search finds. Only
+// the first page of results is counted, so large result sets are
+// undercounted.
+func (c *Client) SearchCount(list, query, searchType string) (int, error) {
+	messages, err := c.Search(list, query, searchType)
+	if err != nil {
+		return 0, err
+	}
+	return len(messages), nil
+}
+
+// SearchCoverage compares cached and live hit counts for a query.
+type SearchCoverage struct {
+	Cached int `json:"cached"`
+	Live   int `json:"live"`
+	// Coverage is Cached/Live capped at 1, and 1 when Live is 0.
+	Coverage float64 `json:"coverage"`
+}
+
+// SearchCoverage reports how many messages of list the cache search finds
+// for query next to the live search count, to judge whether the cache is
+// complete enough for the topic. The cache side uses full-text matching and
+// the live side marc's searchType, so the two only approximate each other.
+func (c *Client) SearchCoverage(list, query, searchType string) (*SearchCoverage, error) {
+	list = c.normalizeList(list)
+
+	cached, err := c.cache.CountSearchMessages(cache.SearchOptions{Query: query, List: list})
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := c.SearchCount(list, query, searchType)
+	if err != nil {
+		return nil, err
+	}
+
+	coverage := 1.0
+	if live > 0 {
+		coverage = min(1, float64(cached)/float64(live))
+	}
+
+	c.logger.Debug("search coverage", "list", list, "query", query, "cached", cached, "live", live)
+	return &SearchCoverage{Cached: cached, Live: live, Coverage: coverage}, nil
+}
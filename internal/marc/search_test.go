@@ -0,0 +1,199 @@
+package marc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+func TestSearchAndCache(t *testing.T) {
+	results := monthPage("git",
+		Message{ID: "1", Date: "2026-02-01", Subject: "fresh hit", Author: "A"},
+		Message{ID: "2", Date: "2026-02-02", Subject: "cached hit", Author: "B"},
+		Message{ID: "3", Date: "2026-02-03", Subject: "broken hit", Author: "C"},
+		Message{ID: "4", Date: "2026-02-04", Subject: "another hit", Author: "D"},
+	)
+
+	var mu sync.Mutex
+	fetched := make(map[string]int)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") != "" {
+			_, _ = io.WriteString(w, results)
+			return
+		}
+
+		id := q.Get("m")
+		mu.Lock()
+		fetched[id]++
+		mu.Unlock()
+		if id == "3" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = io.WriteString(w, messageHTML(
+			[]string{"From: Someone", "Subject: hit " + id},
+			fmt.Sprintf("unusual searchable word xyzzy%s", id),
+		))
+	}))
+
+	if err := c.cache.SetMessageContent(&cache.MessageContent{
+		Message: cache.Message{ID: "2", List: "git", Subject: "cached hit"},
+		Body:    "already here",
+		Headers: map[string]string{},
+	}); err != nil {
+		t.Fatalf("failed to seed cache: %v", err)
+	}
+
+	result, err := c.SearchAndCache("git", "hit", "s")
+	if err != nil {
+		t.Fatalf("SearchAndCache failed: %v", err)
+	}
+
+	if len(result.Messages) != 4 {
+		t.Errorf("expected 4 hits, got %d", len(result.Messages))
+	}
+	if result.Cached != 2 || result.AlreadyCached != 1 || result.Failed != 1 {
+		t.Errorf("cached = %d, already = %d, failed = %d; want 2, 1, 1", result.Cached, result.AlreadyCached, result.Failed)
+	}
+	if fetched["2"] != 0 {
+		t.Error("expected the fresh cached hit not to be fetched")
+	}
+
+	// The fetched content is now searchable offline
+	found, err := c.SearchCached("xyzzy4", "", "", "")
+	if err != nil {
+		t.Fatalf("SearchCached failed: %v", err)
+	}
+	if len(found) != 1 || found[0].ID != "4" {
+		t.Errorf("expected cached hit 4 to be searchable, got %+v", found)
+	}
+}
+
+func TestSearchMulti(t *testing.T) {
+	results := map[string]string{
+		"s": monthPage("git",
+			Message{ID: "3", Date: "2026-02-03", Subject: "leak in refs", Author: "A"},
+			Message{ID: "1", Date: "2026-02-01", Subject: "leak fix", Author: "B"},
+		),
+		"b": monthPage("git",
+			Message{ID: "2", Date: "2026-02-02", Subject: "unrelated subject", Author: "C"},
+			Message{ID: "3", Date: "2026-02-03", Subject: "leak in refs", Author: "A"},
+			Message{ID: "4", Date: "2026-02-04", Subject: "another", Author: "D"},
+		),
+	}
+
+	var mu sync.Mutex
+	searched := make(map[string]int)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searchType := r.URL.Query().Get("q")
+		mu.Lock()
+		searched[searchType]++
+		mu.Unlock()
+		_, _ = io.WriteString(w, results[searchType])
+	}))
+
+	messages, err := c.SearchMulti("git", "leak", []string{"s", "b", "s"})
+	if err != nil {
+		t.Fatalf("SearchMulti failed: %v", err)
+	}
+
+	var ids []string
+	for _, m := range messages {
+		ids = append(ids, m.ID)
+	}
+	if strings.Join(ids, ",") != "3,1,2,4" {
+		t.Errorf("ids = %v, want subject hits then new body hits [3 1 2 4]", ids)
+	}
+	if searched["s"] != 1 || searched["b"] != 1 {
+		t.Errorf("expected one search per type, got %v", searched)
+	}
+}
+
+func TestSearchCoverage(t *testing.T) {
+	live := 0
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var hits []Message
+		for i := range live {
+			hits = append(hits, Message{ID: fmt.Sprint(100 + i), Date: "2026-02-01", Subject: "rebase bug", Author: "A"})
+		}
+		_, _ = io.WriteString(w, monthPage("git", hits...))
+	}))
+
+	for i, list := range []string{"git", "git", "git", "other"} {
+		if err := c.cache.SetMessageContent(&cache.MessageContent{
+			Message: cache.Message{ID: fmt.Sprint(i + 1), List: list, Subject: "rebase bug"},
+			Headers: map[string]string{},
+		}); err != nil {
+			t.Fatalf("failed to seed cache: %v", err)
+		}
+	}
+
+	tests := []struct {
+		live int
+		want SearchCoverage
+	}{
+		{live: 6, want: SearchCoverage{Cached: 3, Live: 6, Coverage: 0.5}},
+		{live: 2, want: SearchCoverage{Cached: 3, Live: 2, Coverage: 1}},
+		{live: 0, want: SearchCoverage{Cached: 3, Live: 0, Coverage: 1}},
+	}
+
+	for _, tt := range tests {
+		live = tt.live
+		got, err := c.SearchCoverage("git", "rebase", "s")
+		if err != nil {
+			t.Fatalf("SearchCoverage failed: %v", err)
+		}
+		if *got != tt.want {
+			t.Errorf("live %d: SearchCoverage() = %+v, want %+v", tt.live, *got, tt.want)
+		}
+	}
+}
+
+func TestSearchAndCacheContextDeadline(t *testing.T) {
+	var hits []Message
+	for i := range 2 * prefetchWorkers {
+		hits = append(hits, Message{ID: fmt.Sprint(i + 1), Date: "2026-02-01", Subject: "slow hit", Author: "A"})
+	}
+	results := monthPage("git", hits...)
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") != "" {
+			_, _ = io.WriteString(w, results)
+			return
+		}
+		// Every fetch hangs until the client gives up
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	result, err := c.SearchAndCacheContext(ctx, "git", "slow", "s")
+	if err != nil {
+		t.Fatalf("SearchAndCacheContext failed: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("caching took %v past a 50ms deadline", elapsed)
+	}
+	if len(result.Messages) != len(hits) {
+		t.Errorf("expected all %d hits listed, got %d", len(hits), len(result.Messages))
+	}
+	// Cancelled fetches are not fetched, not failed
+	if !result.TimedOut || result.Cached != 0 || result.Failed != 0 || result.NotFetched != len(hits) {
+		t.Errorf("TimedOut = %v, Cached = %d, Failed = %d, NotFetched = %d; want true, 0, 0, %d",
+			result.TimedOut, result.Cached, result.Failed, result.NotFetched, len(hits))
+	}
+}
@@ -0,0 +1,96 @@
+package marc
+
+import (
+	"sort"
+	"strings"
+)
+
+// Series is one revision of a patch series posted in a month.
+type Series struct {
+	// Subject is the cover letter's subject without its tags, or the first
+	// patch's when there is no cover letter.
+	Subject string `json:"subject"`
+	Author  string `json:"author"`
+	Version int    `json:"version"`
+	Total   int    `json:"total"`
+	// Complete reports whether every patch 1..Total was found; the cover
+	// letter is not required. Missing lists the absent indexes.
+	Complete bool  `json:"complete"`
+	Missing  []int `json:"missing,omitempty"`
+	// Messages are ordered by series index, cover letter first.
+	Messages []Message `json:"messages"`
+}
+
+// PatchSeries groups a month's patch messages into series. Patches in one
+// series carry different subjects, so members are matched by author,
+// version and series length instead; when the same author posts two such
+// series in a month, a repeated index starts a new one. Replies are not
+// patches and are left out. Series are returned in the order they started.
+func (c *Client) PatchSeries(list, month string) ([]Series, error) {
+	messages, err := c.ListAllMessages(ListMessagesOptions{List: list, Month: month}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("grouping patch series", "list", list, "month", month, "messages", len(messages))
+	return groupSeries(messages), nil
+}
+
+type seriesKey struct {
+	author         string
+	version, total int
+}
+
+// groupSeries groups messages listed newest first into series.
+func groupSeries(messages []Message) []Series {
+	var series []*Series
+	open := make(map[seriesKey]*Series)
+	indexes := make(map[*Series]map[int]bool)
+
+	for i := len(messages) - 1; i >= 0; i-- {
+		m := messages[i]
+		if m.Patch == nil {
+			continue
+		}
+
+		key := seriesKey{author: m.Author, version: m.Patch.Version, total: m.Patch.Total}
+		s, ok := open[key]
+		if !ok || indexes[s][m.Patch.Index] {
+			s = &Series{Author: m.Author, Version: m.Patch.Version, Total: m.Patch.Total}
+			series = append(series, s)
+			open[key] = s
+			indexes[s] = make(map[int]bool)
+		}
+		indexes[s][m.Patch.Index] = true
+		s.Messages = append(s.Messages, m)
+	}
+
+	result := make([]Series, 0, len(series))
+	for _, s := range series {
+		sort.SliceStable(s.Messages, func(i, j int) bool {
+			return s.Messages[i].Patch.Index < s.Messages[j].Patch.Index
+		})
+		s.Subject = patchBaseSubject(s.Messages[0].Subject)
+
+		for idx := 1; idx <= s.Total; idx++ {
+			if !indexes[s][idx] {
+				s.Missing = append(s.Missing, idx)
+			}
+		}
+		s.Complete = len(s.Missing) == 0
+
+		result = append(result, *s)
+	}
+	return result
+}
+
+// patchBaseSubject strips the leading bracketed tags from a subject.
+func patchBaseSubject(subject string) string {
+	for {
+		m := subjectTagRegex.FindStringIndex(subject)
+		if m == nil {
+			return strings.TrimSpace(subject)
+		}
+		subject = subject[m[1]:]
+	}
+}
@@ -0,0 +1,87 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPatchSeries(t *testing.T) {
+	// Newest first, as marc lists a month
+	listing := monthPage("git",
+		Message{ID: "9", Date: "2026-02-09", Subject: "Re: [PATCH v2 1/3] refs: add helper", Author: "Bob"},
+		Message{ID: "8", Date: "2026-02-08", Subject: "[PATCH 3/4] diff: part three", Author: "Carol"},
+		Message{ID: "7", Date: "2026-02-07", Subject: "[PATCH 1/4] diff: part one", Author: "Carol"},
+		Message{ID: "6", Date: "2026-02-06", Subject: "[PATCH v2 3/3] refs: use helper", Author: "Alice"},
+		Message{ID: "5", Date: "2026-02-05", Subject: "[PATCH v2 1/3] refs: add helper", Author: "Alice"},
+		Message{ID: "4", Date: "2026-02-04", Subject: "[PATCH v2 2/3] refs: refactor", Author: "Alice"},
+		Message{ID: "3", Date: "2026-02-03", Subject: "[PATCH v2 0/3] refs: rework locking", Author: "Alice"},
+		Message{ID: "2", Date: "2026-02-02", Subject: "Question about refs", Author: "Dave"},
+		Message{ID: "1", Date: "2026-02-01", Subject: "[PATCH] doc: fix typo", Author: "Eve"},
+	)
+
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("r") == "1" {
+			_, _ = io.WriteString(w, listing)
+			return
+		}
+		_, _ = io.WriteString(w, monthPage("git"))
+	}))
+
+	series, err := c.PatchSeries("git", "202602")
+	if err != nil {
+		t.Fatalf("PatchSeries failed: %v", err)
+	}
+	if len(series) != 3 {
+		t.Fatalf("expected 3 series, got %d: %+v", len(series), series)
+	}
+
+	ids := func(s Series) []string {
+		out := make([]string, len(s.Messages))
+		for i, m := range s.Messages {
+			out[i] = m.ID
+		}
+		return out
+	}
+
+	single := series[0]
+	if single.Subject != "doc: fix typo" || !single.Complete || single.Total != 1 {
+		t.Errorf("unexpected single patch series: %+v", single)
+	}
+
+	complete := series[1]
+	if complete.Subject != "refs: rework locking" || complete.Author != "Alice" || complete.Version != 2 || complete.Total != 3 {
+		t.Errorf("unexpected series metadata: %+v", complete)
+	}
+	if !complete.Complete || complete.Missing != nil {
+		t.Errorf("expected complete series, missing %v", complete.Missing)
+	}
+	if got := ids(complete); !reflect.DeepEqual(got, []string{"3", "5", "4", "6"}) {
+		t.Errorf("series order = %v, want cover letter then 1..3", got)
+	}
+
+	incomplete := series[2]
+	if incomplete.Subject != "diff: part one" || incomplete.Complete {
+		t.Errorf("unexpected incomplete series: %+v", incomplete)
+	}
+	if !reflect.DeepEqual(incomplete.Missing, []int{2, 4}) {
+		t.Errorf("Missing = %v, want [2 4]", incomplete.Missing)
+	}
+}
+
+func TestGroupSeriesSplitsRepeatedIndex(t *testing.T) {
+	patch := func(id, subject string) Message {
+		return Message{ID: id, Subject: subject, Author: "Alice", Patch: patchInfo(subject)}
+	}
+
+	// Two unrelated single patches by one author, newest first
+	series := groupSeries([]Message{
+		patch("2", "[PATCH] second"),
+		patch("1", "[PATCH] first"),
+	})
+
+	if len(series) != 2 || series[0].Subject != "first" || series[1].Subject != "second" {
+		t.Errorf("unexpected series: %+v", series)
+	}
+}
@@ -0,0 +1,84 @@
+package marc
+
+import "errors"
+
+// Fallbacks used when MARC_SERVE_STALE is set and a live fetch fails: serve
+// whatever the cache still holds, ignoring the TTL, flagged as stale. Each
+// reports false when stale serving is off, the failure is not one stale
+// data stands in for, or nothing is cached, in which case the caller
+// returns the original fetch error.
+
+// canServeStale reports whether fetchErr may be answered with stale data:
+// marc.info was unreachable or answered with a retryable status. Errors
+// about the request itself, such as an unknown list, and fetches cut short
+// by a cancelled context or an expired deadline are returned as they are.
+func (c *Client) canServeStale(fetchErr error) bool {
+	if !c.serveStale || c.fetchContext().Err() != nil {
+		return false
+	}
+	var unavailable *unavailableError
+	return errors.As(fetchErr, &unavailable)
+}
+
+func (c *Client) staleMailingLists(fetchErr error) ([]MailingList, bool) {
+	if !c.canServeStale(fetchErr) {
+		return nil, false
+	}
+
+	cached, ok := c.cache.GetStaleMailingLists()
+	if !ok {
+		return nil, false
+	}
+
+	c.logger.Warn("marc.info unreachable, serving stale mailing lists", "error", fetchErr)
+
+	lists := make([]MailingList, len(cached))
+	for i, cl := range cached {
+		lists[i] = mailingListFromCache(cl)
+		lists[i].Stale = true
+	}
+	return lists, true
+}
+
+func (c *Client) staleMessages(list, month string, fetchErr error) ([]Message, bool) {
+	if !c.canServeStale(fetchErr) {
+		return nil, false
+	}
+
+	cached, ok := c.cache.GetStaleMessages(list, month)
+	if !ok {
+		return nil, false
+	}
+
+	c.logger.Warn("marc.info unreachable, serving stale messages", "list", list, "month", month, "error", fetchErr)
+
+	messages := make([]Message, len(cached))
+	for i, cm := range cached {
+		messages[i] = messageFromCache(cm)
+		messages[i].Stale = true
+	}
+	return messages, true
+}
+
+func (c *Client) staleMessageContent(list, messageID string, fetchErr error) (*MessageContent, bool) {
+	if !c.canServeStale(fetchErr) {
+		return nil, false
+	}
+
+	cached, ok := c.cache.GetStaleMessageContent(list, messageID)
+	if !ok {
+		return nil, false
+	}
+
+	c.logger.Warn("marc.info unreachable, serving stale message", "list", list, "messageID", messageID, "error", fetchErr)
+
+	msg := messageFromCache(cached.Message)
+	msg.Stale = true
+
+	return &MessageContent{
+		Message:     msg,
+		Body:        cached.Body,
+		Headers:     cached.Headers,
+		Fingerprint: cached.Fingerprint,
+	}, true
+}
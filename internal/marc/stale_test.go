@@ -0,0 +1,163 @@
+package marc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+func TestServeStaleOnFetchError(t *testing.T) {
+	down := false
+	status := 0
+	missing := false
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != 0 {
+			w.WriteHeader(status)
+			return
+		}
+		if missing {
+			_, _ = io.WriteString(w, "<html><body>No such list</body></html>")
+			return
+		}
+		if down {
+			// Drop the connection like an unreachable upstream would
+			panic(http.ErrAbortHandler)
+		}
+		q := r.URL.Query()
+		switch {
+		case q.Get("m") != "":
+			_, _ = io.WriteString(w, messageHTML([]string{"Subject: Hello", "From: Alice"}, "body"))
+		case q.Get("b") != "":
+			_, _ = io.WriteString(w, monthPage("git", Message{ID: "1", Date: "2026-02-01", Subject: "Hello", Author: "Alice"}))
+		default:
+			_, _ = io.WriteString(w, `<html><body><dl><dt><b><img alt="Group: ">Development</b></dt><dd><a href="?l=git&w=2">git</a></dd></dl></body></html>`)
+		}
+	}))
+
+	// Every entry is expired as soon as it is written
+	expired, err := cache.New(cache.Options{
+		DBPath: filepath.Join(t.TempDir(), "expired.db"),
+		TTL:    time.Nanosecond,
+		Logger: client.logger,
+	})
+	if err != nil {
+		t.Fatalf("failed to create cache: %v", err)
+	}
+	t.Cleanup(func() { expired.Close() })
+	client.cache = expired
+
+	if _, err := client.ListMailingLists(); err != nil {
+		t.Fatalf("ListMailingLists failed: %v", err)
+	}
+	if _, err := client.ListMessages("git", "202602"); err != nil {
+		t.Fatalf("ListMessages failed: %v", err)
+	}
+	if _, err := client.GetMessage("git", "1"); err != nil {
+		t.Fatalf("GetMessage failed: %v", err)
+	}
+
+	down = true
+
+	t.Run("disabled returns the fetch error", func(t *testing.T) {
+		client.serveStale = false
+
+		if _, err := client.ListMailingLists(); err == nil {
+			t.Error("expected ListMailingLists error")
+		}
+		if _, err := client.GetCatalog(); err == nil {
+			t.Error("expected GetCatalog error")
+		}
+		if _, err := client.ListMessages("git", "202602"); err == nil {
+			t.Error("expected ListMessages error")
+		}
+		if _, err := client.GetMessage("git", "1"); err == nil {
+			t.Error("expected GetMessage error")
+		}
+	})
+
+	t.Run("enabled serves expired entries marked stale", func(t *testing.T) {
+		client.serveStale = true
+
+		lists, err := client.ListMailingLists()
+		if err != nil {
+			t.Fatalf("ListMailingLists failed: %v", err)
+		}
+		if len(lists) != 1 || lists[0].Name != "git" || !lists[0].Stale {
+			t.Errorf("unexpected lists: %+v", lists)
+		}
+
+		catalog, err := client.GetCatalog()
+		if err != nil {
+			t.Fatalf("GetCatalog failed: %v", err)
+		}
+		if catalog.ListCount != 1 || len(catalog.Categories) != 1 || catalog.Categories[0].Category != "Development" || !catalog.Categories[0].Lists[0].Stale {
+			t.Errorf("unexpected catalog: %+v", catalog)
+		}
+
+		messages, err := client.ListMessages("git", "202602")
+		if err != nil {
+			t.Fatalf("ListMessages failed: %v", err)
+		}
+		if len(messages) != 1 || messages[0].ID != "1" || !messages[0].Stale {
+			t.Errorf("unexpected messages: %+v", messages)
+		}
+
+		msg, err := client.GetMessage("git", "1")
+		if err != nil {
+			t.Fatalf("GetMessage failed: %v", err)
+		}
+		if msg.Subject != "Hello" || !msg.Stale {
+			t.Errorf("unexpected message: %+v", msg)
+		}
+	})
+
+	t.Run("enabled keeps errors about the request", func(t *testing.T) {
+		client.serveStale = true
+		down = false
+		defer func() { down, status, missing = true, 0, false }()
+
+		missing = true
+		if _, err := client.ListMessages("git", "202602"); err == nil {
+			t.Error("expected ListMessages error for an unknown list")
+		}
+		missing = false
+
+		status = http.StatusNotFound
+		if _, err := client.ListMessages("git", "202602"); err == nil {
+			t.Error("expected ListMessages error for a non-retryable status")
+		}
+
+		status = http.StatusBadGateway
+		messages, err := client.ListMessages("git", "202602")
+		if err != nil {
+			t.Fatalf("ListMessages failed: %v", err)
+		}
+		if len(messages) != 1 || !messages[0].Stale {
+			t.Errorf("expected stale messages for a retryable status, got %+v", messages)
+		}
+	})
+
+	t.Run("enabled keeps cancellation", func(t *testing.T) {
+		client.serveStale = true
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := client.WithContext(ctx).ListMessages("git", "202602"); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected the cancellation error, got %v", err)
+		}
+	})
+
+	t.Run("enabled without cached data still fails", func(t *testing.T) {
+		client.serveStale = true
+
+		if _, err := client.GetMessage("git", "999"); err == nil {
+			t.Error("expected error for uncached message")
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package marc
+
+import (
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MessageStats are engagement figures derived from a message and its
+// thread.
+type MessageStats struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+	// Bytes and Lines measure the trimmed body.
+	Bytes int `json:"bytes"`
+	Lines int `json:"lines"`
+	// QuotedLines start with a quote marker; OriginalLines are the other
+	// non-blank lines, not counting reply attributions.
+	QuotedLines   int `json:"quoted_lines"`
+	OriginalLines int `json:"original_lines"`
+	// DirectReplies counts the later messages of the thread that answer
+	// this one (by In-Reply-To, or the last References entry).
+	DirectReplies int `json:"direct_replies"`
+	// AgeSeconds and Age are the time since the Date header, omitted when
+	// it cannot be parsed.
+	AgeSeconds int64  `json:"age_seconds,omitempty"`
+	Age        string `json:"age,omitempty"`
+}
+
+// MessageStats walks the thread from a message (see GetThread) and returns
+// statistics on its body, its direct replies and its age.
+func (c *Client) MessageStats(list, messageID string) (*MessageStats, error) {
+	messageID, err := NormalizeMessageID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := c.GetThread(list, messageID)
+	if err != nil {
+		return nil, err
+	}
+	msg := thread[0]
+
+	stats := bodyStats(msg.Body)
+	stats.List, stats.MessageID = msg.List, msg.ID
+
+	if id := strings.TrimSpace(headerValue(msg.Headers, "Message-ID")); id != "" {
+		for _, reply := range thread[1:] {
+			if repliesTo(reply.Headers, id) {
+				stats.DirectReplies++
+			}
+		}
+	}
+
+	if date, err := mail.ParseDate(msg.Date); err == nil {
+		age := time.Since(date).Truncate(time.Second)
+		stats.AgeSeconds, stats.Age = int64(age/time.Second), age.String()
+	}
+
+	c.logger.Debug("message stats", "list", stats.List, "messageID", stats.MessageID, "replies", stats.DirectReplies)
+	return stats, nil
+}
+
+// bodyStats measures body and counts its quoted and original lines.
+func bodyStats(body string) *MessageStats {
+	body = strings.TrimSpace(body)
+	stats := &MessageStats{Bytes: len(body)}
+	if body == "" {
+		return stats
+	}
+
+	lines := strings.Split(body, "\n")
+	stats.Lines = len(lines)
+	for _, line := range lines {
+		switch depth, _ := quoteDepth(line); {
+		case depth > 0:
+			stats.QuotedLines++
+		case strings.TrimSpace(line) == "" || attributionRegex.MatchString(strings.TrimSpace(line)):
+		default:
+			stats.OriginalLines++
+		}
+	}
+	return stats
+}
+
+// repliesTo reports whether a message with headers answers the message
+// with Message-ID id directly: In-Reply-To names it, or, without
+// In-Reply-To, it is the last entry of References.
+func repliesTo(headers map[string]string, id string) bool {
+	if inReplyTo := parseReferences(headerValue(headers, "In-Reply-To")); len(inReplyTo) > 0 {
+		return slices.Contains(inReplyTo, id)
+	}
+	refs := parseReferences(headerValue(headers, "References"))
+	return len(refs) > 0 && refs[len(refs)-1] == id
+}
@@ -0,0 +1,59 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestMessageStats(t *testing.T) {
+	body := "Intro line.\n\nOn Mon, Bob wrote:\n> quoted one\n>> quoted two\n\nMy answer."
+	pages := map[string]string{
+		"10": threadPage("11", []string{
+			"Subject: Re: [PATCH] add foo",
+			"From: Alice <alice@example.com>",
+			"Date: Mon, 2 Feb 2026 10:00:00 +0000",
+			"Message-ID: <root@example.com>",
+		}, body),
+		"11": threadPage("12", []string{
+			"Subject: Re: [PATCH] add foo",
+			"Message-ID: <a@example.com>",
+			"In-Reply-To: <root@example.com>",
+		}, "direct"),
+		"12": threadPage("13", []string{
+			"Subject: Re: [PATCH] add foo",
+			"Message-ID: <b@example.com>",
+			"In-Reply-To: <a@example.com>",
+			"References: <root@example.com> <a@example.com>",
+		}, "nested"),
+		"13": threadPage("", []string{
+			"Subject: Re: [PATCH] add foo",
+			"Message-ID: <c@example.com>",
+			"References: <older@example.com> <root@example.com>",
+		}, "direct by references"),
+	}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, pages[r.URL.Query().Get("m")])
+	}))
+
+	stats, err := c.MessageStats("git", "10")
+	if err != nil {
+		t.Fatalf("MessageStats failed: %v", err)
+	}
+
+	if stats.List != "git" || stats.MessageID != "10" {
+		t.Errorf("stats identify %s/%s, want git/10", stats.List, stats.MessageID)
+	}
+	if stats.Bytes != len(body) || stats.Lines != 7 {
+		t.Errorf("size = %d bytes, %d lines; want %d bytes, 7 lines", stats.Bytes, stats.Lines, len(body))
+	}
+	if stats.QuotedLines != 2 || stats.OriginalLines != 2 {
+		t.Errorf("quoted = %d, original = %d; want 2 and 2", stats.QuotedLines, stats.OriginalLines)
+	}
+	if stats.DirectReplies != 2 {
+		t.Errorf("direct replies = %d, want 2", stats.DirectReplies)
+	}
+	if stats.AgeSeconds <= 0 || stats.Age == "" {
+		t.Errorf("expected an age, got %d (%q)", stats.AgeSeconds, stats.Age)
+	}
+}
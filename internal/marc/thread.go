@@ -0,0 +1,165 @@
+package marc
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrMessageIDNotFound is returned when marc.info has no message for a
+// Message-ID.
+var ErrMessageIDNotFound = errors.New("message-id not found")
+
+// Match a single <local@domain> Message-ID in a References-style header
+var messageIDRegex = regexp.MustCompile(`<[^<>\s]+>`)
+
+// Match the "next in thread" navigation link on a message page
+var nextInThreadRegex = regexp.MustCompile(`href="\?l=([^&"]+)&(?:amp;)?m=(\d+)[^"]*"\s*>next in thread</a>`)
+
+// maxThreadMessages bounds how many messages GetThread follows.
+const maxThreadMessages = 500
+
+// ResolveMessageID maps an RFC822 Message-ID to the list and numeric marc
+// ID it was archived under.
+func (c *Client) ResolveMessageID(messageID string) (list, id string, err error) {
+	c.logger.Debug("resolving message-id", "messageID", messageID)
+
+	raw, finalURL, err := c.fetchWithRetry(messageIDLookupPath(messageID))
+	if err != nil {
+		return "", "", err
+	}
+
+	// marc redirects straight to the message when the lookup is unambiguous
+	if finalURL != nil {
+		q := finalURL.Query()
+		if q.Get("l") != "" && q.Get("m") != "" {
+			return q.Get("l"), q.Get("m"), nil
+		}
+	}
+
+	// Otherwise it shows a result page; take the first message link
+	if m := messageLinkRegex.FindStringSubmatch(raw); len(m) == 3 {
+		return m[1], m[2], nil
+	}
+
+	return "", "", fmt.Errorf("%w: %s", ErrMessageIDNotFound, messageID)
+}
+
+// Ancestry is a message together with the ancestors named in its
+// References header, oldest first.
+type Ancestry struct {
+	Messages   []MessageContent `json:"messages"`
+	Unresolved []string         `json:"unresolved,omitempty"`
+}
+
+// MessageWithAncestry returns the message and every resolvable ancestor in
+// its References chain, oldest first and ending with the message itself.
+func (c *Client) MessageWithAncestry(list, messageID string) ([]MessageContent, error) {
+	a, err := c.Ancestry(list, messageID)
+	if err != nil {
+		return nil, err
+	}
+	return a.Messages, nil
+}
+
+// Ancestry is like MessageWithAncestry but also reports the references that
+// could not be resolved or fetched.
+func (c *Client) Ancestry(list, messageID string) (*Ancestry, error) {
+	msg, err := c.GetMessage(list, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Ancestry{Messages: make([]MessageContent, 0)}
+
+	refs := parseReferences(headerValue(msg.Headers, "References"))
+	if len(refs) == 0 {
+		refs = parseReferences(headerValue(msg.Headers, "In-Reply-To"))
+	}
+
+	for _, ref := range refs {
+		refList, refID, err := c.ResolveMessageID(ref)
+		if err != nil {
+			c.logger.Debug("skipping unresolved reference", "ref", ref, "error", err)
+			result.Unresolved = append(result.Unresolved, ref)
+			continue
+		}
+
+		parent, err := c.GetMessage(refList, refID)
+		if err != nil {
+			c.logger.Debug("skipping unfetchable reference", "ref", ref, "error", err)
+			result.Unresolved = append(result.Unresolved, ref)
+			continue
+		}
+		result.Messages = append(result.Messages, *parent)
+	}
+
+	result.Messages = append(result.Messages, *msg)
+	return result, nil
+}
+
+// GetThread returns the thread starting at rootMessageID in marc's thread
+// order, following each message page's "next in thread" link. Pass the
+// thread's first message to get the whole thread.
+func (c *Client) GetThread(list, rootMessageID string) ([]MessageContent, error) {
+	list = c.normalizeList(list)
+
+	c.logger.Debug("getting thread", "list", list, "root", rootMessageID)
+
+	thread := make([]MessageContent, 0)
+	seen := make(map[string]bool)
+
+	for id := rootMessageID; id != "" && !seen[id] && len(thread) < maxThreadMessages; {
+		seen[id] = true
+
+		// The navigation links are not cached, so always fetch the page
+		raw, canonical, err := c.fetchList(messagePath(list, id), list)
+		if err != nil {
+			return nil, err
+		}
+		list = canonical
+
+		msg, err := parseMessageVerbatim(raw, list, id)
+		if err != nil {
+			return nil, err
+		}
+		c.storeMessageContent(msg)
+		msg.Body = strings.TrimSpace(msg.Body)
+		thread = append(thread, *msg)
+
+		id = nextInThread(raw, list)
+	}
+
+	c.logger.Debug("found thread", "root", rootMessageID, "count", len(thread))
+	return thread, nil
+}
+
+// nextInThread returns the marc ID linked as "next in thread", or "" at the
+// end of the thread. Links leading to another list are not followed.
+func nextInThread(raw, list string) string {
+	m := nextInThreadRegex.FindStringSubmatch(raw)
+	if m == nil || m[1] != list {
+		return ""
+	}
+	return m[2]
+}
+
+// parseReferences extracts Message-IDs from a References or In-Reply-To
+// header in the order they appear (oldest first for References).
+func parseReferences(header string) []string {
+	return messageIDRegex.FindAllString(header, -1)
+}
+
+// headerValue looks up a header case-insensitively.
+func headerValue(headers map[string]string, key string) string {
+	if v, ok := headers[key]; ok {
+		return v
+	}
+	for k, v := range headers {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,266 @@
+package marc
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+// messageHTML renders a message page the way marc.info shows it: headers and
+// body inside a single <pre> block, HTML-escaped.
+func messageHTML(headers []string, body string) string {
+	escape := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace
+	return fmt.Sprintf("<html><body><pre>\n%s\n\n%s\n</pre></body></html>",
+		escape(strings.Join(headers, "\n")), escape(body))
+}
+
+func TestParseReferences(t *testing.T) {
+	got := parseReferences("<a@example.com>\n\t<b@example.com> junk <c@example.com>")
+	want := []string{"<a@example.com>", "<b@example.com>", "<c@example.com>"}
+
+	if len(got) != len(want) {
+		t.Fatalf("parseReferences() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ref %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMessageWithAncestry(t *testing.T) {
+	pages := map[string]string{
+		"1": messageHTML([]string{
+			"Subject: [PATCH] root",
+			"From: Alice <alice@example.com>",
+			"Message-ID: <root@example.com>",
+		}, "root body"),
+		"2": messageHTML([]string{
+			"Subject: Re: [PATCH] root",
+			"From: Bob <bob@example.com>",
+			"Message-ID: <reply@example.com>",
+			"References: <root@example.com>",
+		}, "reply body"),
+		"3": messageHTML([]string{
+			"Subject: Re: [PATCH] root",
+			"From: Carol <carol@example.com>",
+			"Message-ID: <leaf@example.com>",
+			"References: <root@example.com> <missing@example.com> <reply@example.com>",
+		}, "leaf body"),
+	}
+	lookup := map[string]string{
+		"root@example.com":  "1",
+		"reply@example.com": "2",
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if msgID := q.Get("i"); msgID != "" {
+			id, ok := lookup[msgID]
+			if !ok {
+				_, _ = io.WriteString(w, "<html><body>No hits found</body></html>")
+				return
+			}
+			http.Redirect(w, r, "/?l=git&m="+id+"&w=2", http.StatusFound)
+			return
+		}
+		_, _ = io.WriteString(w, pages[q.Get("m")])
+	}))
+
+	ancestry, err := client.Ancestry("git", "3")
+	if err != nil {
+		t.Fatalf("Ancestry failed: %v", err)
+	}
+
+	gotIDs := make([]string, len(ancestry.Messages))
+	for i, m := range ancestry.Messages {
+		gotIDs[i] = m.ID
+	}
+	if strings.Join(gotIDs, ",") != "1,2,3" {
+		t.Errorf("chain = %v, want [1 2 3]", gotIDs)
+	}
+	if len(ancestry.Unresolved) != 1 || ancestry.Unresolved[0] != "<missing@example.com>" {
+		t.Errorf("unresolved = %v, want [<missing@example.com>]", ancestry.Unresolved)
+	}
+
+	messages, err := client.MessageWithAncestry("git", "3")
+	if err != nil {
+		t.Fatalf("MessageWithAncestry failed: %v", err)
+	}
+	if len(messages) != 3 || messages[2].Author != "Carol <carol@example.com>" {
+		t.Errorf("unexpected chain: %+v", messages)
+	}
+}
+
+// threadPage is messageHTML with marc's thread navigation line linking to
+// next (or an unlinked "next in thread" when next is empty).
+func threadPage(next string, headers []string, body string) string {
+	nav := "[next in thread]"
+	if next != "" {
+		nav = fmt.Sprintf(`[<a href="?l=git&amp;m=%s&amp;w=2">next in thread</a>]`, next)
+	}
+	return strings.Replace(messageHTML(headers, body), "<pre>\n", "<pre>\n"+nav+"\n", 1)
+}
+
+func TestThreadDocument(t *testing.T) {
+	pages := map[string]string{
+		"10": threadPage("11", []string{
+			"Subject: [PATCH] add foo",
+			"From: Alice <alice@example.com>",
+			"Date: Mon, 2 Feb 2026 10:00:00 +0000",
+		}, "This adds foo."),
+		"11": threadPage("12", []string{
+			"Subject: Re: [PATCH] add foo",
+			"From: Bob <bob@example.com>",
+			"Date: Mon, 2 Feb 2026 11:00:00 +0000",
+		}, "> This adds foo.\n\nLooks good."),
+		"12": threadPage("", []string{
+			"Subject: Re: [PATCH] add foo",
+			"From: Carol <carol@example.com>",
+			"Date: Mon, 2 Feb 2026 12:00:00 +0000",
+		}, "> > This adds foo.\n> Looks good.\n\nApplied, thanks."),
+	}
+
+	var requests int
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = io.WriteString(w, pages[r.URL.Query().Get("m")])
+	}))
+
+	t.Run("follows next in thread", func(t *testing.T) {
+		thread, err := client.GetThread("git", "10")
+		if err != nil {
+			t.Fatalf("GetThread failed: %v", err)
+		}
+		if len(thread) != 3 || thread[0].ID != "10" || thread[2].ID != "12" {
+			t.Fatalf("unexpected thread: %+v", thread)
+		}
+	})
+
+	t.Run("renders separators in order", func(t *testing.T) {
+		doc, err := client.ThreadDocument("git", "10", false)
+		if err != nil {
+			t.Fatalf("ThreadDocument failed: %v", err)
+		}
+
+		separators := []string{
+			"--- Message 1: [PATCH] add foo by Alice <alice@example.com> on Mon, 2 Feb 2026 10:00:00 +0000 ---",
+			"--- Message 2: Re: [PATCH] add foo by Bob <bob@example.com> on Mon, 2 Feb 2026 11:00:00 +0000 ---",
+			"--- Message 3: Re: [PATCH] add foo by Carol <carol@example.com> on Mon, 2 Feb 2026 12:00:00 +0000 ---",
+		}
+		last := -1
+		for _, sep := range separators {
+			idx := strings.Index(doc, sep)
+			if idx < 0 {
+				t.Fatalf("missing separator %q in:\n%s", sep, doc)
+			}
+			if idx <= last {
+				t.Errorf("separator %q out of order", sep)
+			}
+			last = idx
+		}
+		if !strings.Contains(doc, "> This adds foo.") {
+			t.Error("quotes should be kept without strip_quotes")
+		}
+	})
+
+	t.Run("strips quotes and caches", func(t *testing.T) {
+		doc, err := client.ThreadDocument("git", "10", true)
+		if err != nil {
+			t.Fatalf("ThreadDocument failed: %v", err)
+		}
+		for _, line := range strings.Split(doc, "\n") {
+			if strings.HasPrefix(line, ">") {
+				t.Errorf("quoted line %q should be stripped", line)
+			}
+		}
+		if !strings.HasSuffix(doc, "Applied, thanks.") {
+			t.Errorf("unexpected document tail:\n%s", doc)
+		}
+
+		before := requests
+		again, err := client.ThreadDocument("git", "10", true)
+		if err != nil {
+			t.Fatalf("ThreadDocument failed: %v", err)
+		}
+		if again != doc {
+			t.Error("cached document differs")
+		}
+		if requests != before {
+			t.Errorf("expected cached document, made %d requests", requests-before)
+		}
+	})
+}
+
+func TestThreadHTML(t *testing.T) {
+	pages := map[string]string{
+		"20": threadPage("21", []string{
+			"Subject: <script>alert(1)</script> & friends",
+			"From: Mallory <mallory@example.com>",
+			"Date: Tue, 3 Feb 2026 10:00:00 +0000",
+		}, "Body with </pre><img src=x onerror=alert(1)> inside"),
+		"21": threadPage("", []string{
+			"Subject: Re: plain",
+			"From: Bob <bob@example.com>",
+			"Date: Tue, 3 Feb 2026 11:00:00 +0000",
+		}, "> quoted\n\nReply body"),
+	}
+
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, pages[r.URL.Query().Get("m")])
+	}))
+
+	page, err := client.ThreadHTML("git", "20")
+	if err != nil {
+		t.Fatalf("ThreadHTML failed: %v", err)
+	}
+
+	doc, err := html.Parse(strings.NewReader(page))
+	if err != nil {
+		t.Fatalf("output is not parseable HTML: %v", err)
+	}
+
+	// Collect what a browser would see: element names and the text of the
+	// headings and bodies
+	elements := make(map[string]int)
+	var headings, bodies []string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			elements[n.Data]++
+			switch n.Data {
+			case "h2":
+				headings = append(headings, extractText(n))
+			case "pre":
+				bodies = append(bodies, extractText(n))
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	if elements["script"] != 0 || elements["img"] != 0 {
+		t.Errorf("message content was not escaped, found elements %v", elements)
+	}
+	if elements["section"] != 2 {
+		t.Errorf("expected 2 sections, got %d", elements["section"])
+	}
+
+	wantHeadings := []string{"<script>alert(1)</script> & friends", "Re: plain"}
+	if strings.Join(headings, "|") != strings.Join(wantHeadings, "|") {
+		t.Errorf("headings = %q, want %q", headings, wantHeadings)
+	}
+	wantBodies := []string{"Body with </pre><img src=x onerror=alert(1)> inside", "> quoted\n\nReply body"}
+	if strings.Join(bodies, "|") != strings.Join(wantBodies, "|") {
+		t.Errorf("bodies = %q, want %q", bodies, wantBodies)
+	}
+	if !strings.Contains(page, "Mallory &lt;mallory@example.com&gt;") {
+		t.Error("expected escaped author")
+	}
+}
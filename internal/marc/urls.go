@@ -0,0 +1,145 @@
+package marc
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Path builders for the marc.info views we scrape. Every fetch goes through
+// one of these so dry runs report exactly the URL a real call would hit.
+
+// listMessagesPath builds a month listing URL; r=N is the page number.
+func listMessagesPath(list, month string, page int) string {
+	return fmt.Sprintf("?l=%s&b=%s&r=%d&w=2", url.QueryEscape(list), url.QueryEscape(month), page)
+}
+
+// monthIndexPath builds the URL of a list's page of archived months.
+func monthIndexPath(list string) string {
+	return fmt.Sprintf("?l=%s&w=2", url.QueryEscape(list))
+}
+
+func messagePath(list, messageID string) string {
+	return fmt.Sprintf("?l=%s&m=%s&w=2", url.QueryEscape(list), url.QueryEscape(messageID))
+}
+
+// messageSourcePath builds the URL of marc's "Download RAW message" view.
+func messageSourcePath(list, messageID string) string {
+	return fmt.Sprintf("?l=%s&m=%s&q=raw", url.QueryEscape(list), url.QueryEscape(messageID))
+}
+
+// searchPath builds a search URL; s is the query and q the search type
+// (s=subject, a=author, b=body).
+func searchPath(list, query, searchType string) string {
+	return fmt.Sprintf("?l=%s&s=%s&q=%s&w=2",
+		url.QueryEscape(list),
+		url.QueryEscape(query),
+		url.QueryEscape(searchType))
+}
+
+// ErrInvalidMessageID is returned when no numeric marc message ID can be
+// found in the input.
+var ErrInvalidMessageID = errors.New("invalid message id")
+
+// NormalizeMessageID extracts the numeric marc message ID from the forms
+// users tend to paste: a bare number, "#123456", "m=123456&w=2", a query
+// string or a full marc.info URL.
+func NormalizeMessageID(raw string) (string, error) {
+	s := strings.TrimPrefix(strings.TrimSpace(raw), "#")
+	if isNumeric(s) {
+		return s, nil
+	}
+
+	if i := strings.IndexByte(s, '?'); i >= 0 {
+		s = s[i+1:]
+	}
+	if i := strings.IndexByte(s, '#'); i >= 0 {
+		if frag := s[i+1:]; isNumeric(frag) {
+			return frag, nil
+		}
+		s = s[:i]
+	}
+
+	if q, err := url.ParseQuery(s); err == nil && isNumeric(q.Get("m")) {
+		return q.Get("m"), nil
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrInvalidMessageID, raw)
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DryRun describes the request a tool call would make, with defaults
+// resolved, without contacting marc.info.
+type DryRun struct {
+	URL        string `json:"url"`
+	List       string `json:"list"`
+	Month      string `json:"month,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	MessageID  string `json:"message_id,omitempty"`
+	Query      string `json:"query,omitempty"`
+	SearchType string `json:"search_type,omitempty"`
+}
+
+func (c *Client) PlanListMessages(opts ListMessagesOptions) (*DryRun, error) {
+	opts, err := c.resolveListOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DryRun{
+		URL:   c.baseURL + listMessagesPath(opts.List, opts.Month, opts.Page),
+		List:  opts.List,
+		Month: opts.Month,
+		Page:  opts.Page,
+		Limit: opts.Limit,
+	}, nil
+}
+
+func (c *Client) PlanGetMessage(list, messageID string) (*DryRun, error) {
+	list = c.normalizeList(list)
+
+	messageID, err := NormalizeMessageID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DryRun{
+		URL:       c.baseURL + messagePath(list, messageID),
+		List:      list,
+		MessageID: messageID,
+	}, nil
+}
+
+func (c *Client) PlanSearch(list, query, searchType string) *DryRun {
+	list = c.normalizeList(list)
+
+	if searchType == "" {
+		searchType = "s"
+	}
+
+	return &DryRun{
+		URL:        c.baseURL + searchPath(list, query, searchType),
+		List:       list,
+		Query:      query,
+		SearchType: searchType,
+	}
+}
+
+// messageIDLookupPath builds marc's Message-ID lookup URL, which redirects
+// to the archived message.
+func messageIDLookupPath(messageID string) string {
+	return "?i=" + url.QueryEscape(strings.Trim(messageID, "<>"))
+}
@@ -0,0 +1,100 @@
+package marc
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDryRunMakesNoRequests(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected HTTP request during dry run: %s", r.URL)
+	}))
+
+	t.Run("list messages resolves defaults", func(t *testing.T) {
+		plan, err := client.PlanListMessages(ListMessagesOptions{List: "git"})
+		if err != nil {
+			t.Fatalf("PlanListMessages failed: %v", err)
+		}
+
+		month := time.Now().Format("200601")
+		if plan.Month != month {
+			t.Errorf("Month = %q, want %q", plan.Month, month)
+		}
+		if plan.Page != 1 {
+			t.Errorf("Page = %d, want 1", plan.Page)
+		}
+		wantURL := client.baseURL + "?l=git&b=" + month + "&r=1&w=2"
+		if plan.URL != wantURL {
+			t.Errorf("URL = %q, want %q", plan.URL, wantURL)
+		}
+	})
+
+	t.Run("list messages rejects invalid month", func(t *testing.T) {
+		if _, err := client.PlanListMessages(ListMessagesOptions{List: "git", Month: "2026-02"}); err == nil {
+			t.Error("expected error for invalid month")
+		}
+	})
+
+	t.Run("get message", func(t *testing.T) {
+		plan, err := client.PlanGetMessage("git", "m=123&w=2")
+		if err != nil {
+			t.Fatalf("PlanGetMessage failed: %v", err)
+		}
+		if !strings.HasSuffix(plan.URL, "?l=git&m=123&w=2") {
+			t.Errorf("URL = %q", plan.URL)
+		}
+	})
+
+	t.Run("search escapes query and defaults type", func(t *testing.T) {
+		plan := client.PlanSearch("git", "memory leak", "")
+		if plan.SearchType != "s" {
+			t.Errorf("SearchType = %q, want %q", plan.SearchType, "s")
+		}
+		if !strings.HasSuffix(plan.URL, "?l=git&s=memory+leak&q=s&w=2") {
+			t.Errorf("URL = %q", plan.URL)
+		}
+	})
+}
+
+func TestNormalizeMessageID(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{"bare number", "177190123456789", "177190123456789", false},
+		{"surrounding whitespace", "  123456\n", "123456", false},
+		{"fragment", "#123456", "123456", false},
+		{"m parameter", "m=123456", "123456", false},
+		{"m parameter with trailing params", "m=123456&w=2", "123456", false},
+		{"query string", "?l=git&m=123456&w=2", "123456", false},
+		{"full url", "https://marc.info/?l=git&m=123456&w=2", "123456", false},
+		{"url with fragment", "https://marc.info/#123456", "123456", false},
+		{"non-numeric", "abc", "", true},
+		{"non-numeric m", "m=12ab", "", true},
+		{"empty", "", "", true},
+		{"url without id", "https://marc.info/?l=git&w=2", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeMessageID(tt.raw)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidMessageID) {
+					t.Fatalf("NormalizeMessageID(%q) error = %v, want ErrInvalidMessageID", tt.raw, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeMessageID(%q) failed: %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeMessageID(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
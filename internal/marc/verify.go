@@ -0,0 +1,84 @@
+package marc
+
+import "slices"
+
+// VerifyResult compares a cached month listing with marc.info.
+type VerifyResult struct {
+	List   string `json:"list"`
+	Month  string `json:"month"`
+	Live   int    `json:"live"`
+	Cached int    `json:"cached"`
+	// OnlyLive are IDs marc.info lists that the cache lacks (stale or
+	// incomplete cache); OnlyCached are cached IDs marc.info no longer
+	// lists (deleted upstream or kept too long). Both ascend.
+	OnlyLive   []string `json:"only_live"`
+	OnlyCached []string `json:"only_cached"`
+	Consistent bool     `json:"consistent"`
+}
+
+// VerifyMonth fetches every page of a month from marc.info and diffs its
+// message IDs against the cached listing, expired entries included.
+// Nothing is written to the cache.
+func (c *Client) VerifyMonth(list, month string) (*VerifyResult, error) {
+	opts, err := c.resolveListOptions(ListMessagesOptions{List: list, Month: month})
+	if err != nil {
+		return nil, err
+	}
+	list, month = opts.List, opts.Month
+
+	live := make(map[string]bool)
+	for page := 1; page <= maxMonthPages; page++ {
+		listing, err := c.fetchListingPage(list, month, page)
+		if err != nil {
+			return nil, err
+		}
+		list = listing.list
+
+		fresh := 0
+		for _, m := range listing.messages {
+			if !live[m.ID] {
+				live[m.ID] = true
+				fresh++
+			}
+		}
+		// As in ListAllMessages: an empty or repeated page ends the month,
+		// and so does a short last page once the page size is known
+		if fresh == 0 {
+			break
+		}
+		if size, ok := c.detectedPageSize(list); ok && !listing.hasNext && len(listing.messages) < size {
+			break
+		}
+	}
+
+	cached := make(map[string]bool)
+	stale, _ := c.cache.GetStaleMessages(list, month)
+	for _, m := range stale {
+		cached[m.ID] = true
+	}
+
+	result := &VerifyResult{
+		List:       list,
+		Month:      month,
+		Live:       len(live),
+		Cached:     len(cached),
+		OnlyLive:   missingFrom(live, cached),
+		OnlyCached: missingFrom(cached, live),
+	}
+	result.Consistent = len(result.OnlyLive) == 0 && len(result.OnlyCached) == 0
+
+	c.logger.Debug("verified month", "list", list, "month", month, "live", result.Live, "cached", result.Cached, "consistent", result.Consistent)
+	return result, nil
+}
+
+// missingFrom returns the IDs of a that b lacks, in ascending order.
+func missingFrom(a, b map[string]bool) []string {
+	missing := make([]string, 0)
+	for id := range a {
+		if !b[id] {
+			missing = append(missing, id)
+		}
+	}
+	slices.SortFunc(missing, compareIDs)
+	return missing
+}
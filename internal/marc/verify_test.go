@@ -0,0 +1,57 @@
+package marc
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/andr1an/marc-mcp/internal/cache"
+)
+
+func TestVerifyMonth(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("r") != "1" {
+			// Later pages repeat nothing new
+			_, _ = io.WriteString(w, monthPage("git"))
+			return
+		}
+		_, _ = io.WriteString(w, monthPage("git",
+			Message{ID: "4", Date: "2024-01-04", Subject: "new upstream", Author: "A"},
+			Message{ID: "2", Date: "2024-01-02", Subject: "both", Author: "A"},
+			Message{ID: "1", Date: "2024-01-01", Subject: "both", Author: "A"},
+		))
+	}))
+
+	seeded := []cache.Message{
+		{ID: "3", List: "git", Subject: "deleted upstream", Author: "B", Date: "2024-01-03"},
+		{ID: "2", List: "git", Subject: "both", Author: "A", Date: "2024-01-02"},
+		{ID: "1", List: "git", Subject: "both", Author: "A", Date: "2024-01-01"},
+	}
+	if err := c.cache.SetMessages(seeded); err != nil {
+		t.Fatalf("failed to seed cache: %v", err)
+	}
+
+	result, err := c.VerifyMonth("git", "202401")
+	if err != nil {
+		t.Fatalf("VerifyMonth failed: %v", err)
+	}
+
+	want := &VerifyResult{
+		List:       "git",
+		Month:      "202401",
+		Live:       3,
+		Cached:     3,
+		OnlyLive:   []string{"4"},
+		OnlyCached: []string{"3"},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("VerifyMonth = %+v, want %+v", result, want)
+	}
+
+	// Verification is read-only
+	cached, _ := c.cache.GetMessages("git", "202401")
+	if len(cached) != len(seeded) {
+		t.Errorf("expected the cache untouched, got %+v", cached)
+	}
+}
@@ -0,0 +1,75 @@
+package marc
+
+import (
+	"context"
+	"time"
+)
+
+// WaitForNewMessages polls the first page of list's current month every
+// interval until it shows messages whose IDs are not in baseline, and
+// returns those in listing order. An empty baseline means the messages
+// listed when the call starts. Polls always go to marc.info, bypassing the
+// listing cache, and refresh it. When maxWait passes without anything new,
+// an empty slice is returned; when ctx ends first, its error is. Failed
+// polls after the first are logged and retried on the next tick.
+func (c *Client) WaitForNewMessages(ctx context.Context, list string, baseline []string, interval, maxWait time.Duration) ([]Message, error) {
+	list = c.normalizeList(list)
+
+	seen := make(map[string]bool, len(baseline))
+	for _, id := range baseline {
+		seen[id] = true
+	}
+
+	poll := func() ([]Message, error) {
+		messages, _, err := c.fetchMessagePage(list, time.Now().Format("200601"), 1)
+		if err != nil {
+			return nil, err
+		}
+		c.storeMessages(messages)
+		return messages, nil
+	}
+
+	if len(baseline) == 0 {
+		messages, err := poll()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range messages {
+			seen[m.ID] = true
+		}
+	}
+
+	c.logger.Debug("watching list", "list", list, "baseline", len(seen), "interval", interval, "max_wait", maxWait)
+
+	deadline := time.NewTimer(maxWait)
+	defer deadline.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline.C:
+			return make([]Message, 0), nil
+		case <-ticker.C:
+		}
+
+		messages, err := poll()
+		if err != nil {
+			c.logger.Warn("watch poll failed", "list", list, "error", err)
+			continue
+		}
+
+		fresh := make([]Message, 0)
+		for _, m := range messages {
+			if !seen[m.ID] {
+				fresh = append(fresh, m)
+			}
+		}
+		if len(fresh) > 0 {
+			c.logger.Debug("new messages", "list", list, "count", len(fresh))
+			return fresh, nil
+		}
+	}
+}
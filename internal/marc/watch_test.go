@@ -0,0 +1,86 @@
+package marc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForNewMessages(t *testing.T) {
+	old := Message{ID: "1", Date: "2026-02-01", Subject: "old", Author: "A"}
+	fresh := Message{ID: "2", Date: "2026-02-02", Subject: "new", Author: "B"}
+
+	// The listing grows once grown is set
+	var grown atomic.Bool
+	var polls atomic.Int32
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls.Add(1)
+		if grown.Load() {
+			_, _ = io.WriteString(w, monthPage("git", fresh, old))
+			return
+		}
+		_, _ = io.WriteString(w, monthPage("git", old))
+	}))
+
+	t.Run("returns messages added after the snapshot", func(t *testing.T) {
+		grown.Store(false)
+		time.AfterFunc(50*time.Millisecond, func() { grown.Store(true) })
+
+		got, err := c.WaitForNewMessages(context.Background(), "git", nil, 10*time.Millisecond, 5*time.Second)
+		if err != nil {
+			t.Fatalf("WaitForNewMessages failed: %v", err)
+		}
+		if len(got) != 1 || got[0].ID != "2" {
+			t.Errorf("expected message 2, got %+v", got)
+		}
+	})
+
+	t.Run("compares against the given baseline", func(t *testing.T) {
+		grown.Store(true)
+		polls.Store(0)
+
+		got, err := c.WaitForNewMessages(context.Background(), "git", []string{"1"}, 10*time.Millisecond, 5*time.Second)
+		if err != nil {
+			t.Fatalf("WaitForNewMessages failed: %v", err)
+		}
+		if len(got) != 1 || got[0].ID != "2" {
+			t.Errorf("expected message 2, got %+v", got)
+		}
+		// Listing is always fetched live, even though it is now cached
+		if polls.Load() != 1 {
+			t.Errorf("expected one poll, got %d", polls.Load())
+		}
+	})
+
+	t.Run("gives up after max", func(t *testing.T) {
+		grown.Store(false)
+
+		start := time.Now()
+		got, err := c.WaitForNewMessages(context.Background(), "git", nil, 10*time.Millisecond, 80*time.Millisecond)
+		if err != nil {
+			t.Fatalf("WaitForNewMessages failed: %v", err)
+		}
+		if got == nil || len(got) != 0 {
+			t.Errorf("expected an empty result, got %+v", got)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("took %v to give up", elapsed)
+		}
+	})
+
+	t.Run("stops when the context ends", func(t *testing.T) {
+		grown.Store(false)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		_, err := c.WaitForNewMessages(ctx, "git", nil, 10*time.Millisecond, time.Minute)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type AdminReconfigureTool struct {
+	client *marc.Client
+}
+
+type AdminReconfigureInput struct {
+	Timeout   string   `json:"timeout,omitempty"`
+	RateLimit *float64 `json:"rate_limit,omitempty"`
+}
+
+func NewAdminReconfigureTool(client *marc.Client) Tool {
+	return &AdminReconfigureTool{client: client}
+}
+
+func (t *AdminReconfigureTool) Name() string {
+	return "admin_reconfigure"
+}
+
+func (t *AdminReconfigureTool) Description() string {
+	return "Change the marc.info HTTP timeout and request rate limit at runtime. Omitted settings are kept; call with no arguments to read the current settings."
+}
+
+func (t *AdminReconfigureTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"timeout": map[string]any{
+				"type":        "string",
+				"description": "HTTP timeout as a Go duration (e.g., '30s'), between 10s and 15m",
+			},
+			"rate_limit": map[string]any{
+				"type":        "number",
+				"description": "Maximum requests per second to marc.info; 0 disables the limit",
+			},
+		},
+		"required":             []string{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *AdminReconfigureTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req AdminReconfigureInput
+	if len(input) > 0 {
+		if err := json.Unmarshal(input, &req); err != nil {
+			return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+		}
+	}
+
+	cfg := client.Config()
+	if req.Timeout != "" {
+		d, err := time.ParseDuration(req.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid timeout: %v", ErrInvalidArgument, err)
+		}
+		cfg.Timeout = d
+	}
+	if req.RateLimit != nil {
+		cfg.RateLimit = *req.RateLimit
+	}
+
+	if req.Timeout != "" || req.RateLimit != nil {
+		if err := client.Reconfigure(cfg); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+	}
+
+	return map[string]any{
+		"timeout":    cfg.Timeout.String(),
+		"rate_limit": cfg.RateLimit,
+	}, nil
+}
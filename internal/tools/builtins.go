@@ -13,6 +13,12 @@ var (
 	clientErr  error
 )
 
+// SharedClient returns the process-wide marc client used by the builtin
+// tools, creating it on first use.
+func SharedClient() (*marc.Client, error) {
+	return getClient()
+}
+
 func getClient() (*marc.Client, error) {
 	clientMu.Lock()
 	defer clientMu.Unlock()
@@ -35,9 +41,58 @@ func RegisterBuiltins(registry *Registry) error {
 	}
 
 	registry.Register(NewListMailingListsTool(client))
+	registry.Register(NewGetCatalogTool(client))
+	registry.Register(NewListCategoryTool(client))
+	registry.Register(NewListInfoTool(client))
 	registry.Register(NewListMessagesTool(client))
 	registry.Register(NewGetMessageTool(client))
+	registry.Register(NewGetMessageMarkdownTool(client))
+	registry.Register(NewExtractQuotesTool(client))
 	registry.Register(NewSearchMessagesTool(client))
+	registry.Register(NewGetMessageSourceTool(client))
+	registry.Register(NewMessageFingerprintTool(client))
+	registry.Register(NewMessageStatsTool(client))
+	registry.Register(NewThreadByRootAuthorTool(client))
+	registry.Register(NewCachedMonthsTool(client))
+	registry.Register(NewVerifyMonthTool(client))
+	registry.Register(NewListFacetsTool(client))
+	registry.Register(NewListMonthsTool(client))
+	registry.Register(NewEarliestMessageTool(client))
+	registry.Register(NewListsActiveSinceTool(client))
+	registry.Register(NewRecentAcrossListsTool(client))
+	registry.Register(NewWatchListTool(client))
+	registry.Register(NewMessageAncestryTool(client))
+	registry.Register(NewReferencedMessagesTool(client))
+	registry.Register(NewThreadDocumentTool(client))
+	registry.Register(NewThreadHTMLTool(client))
+	registry.Register(NewExportMboxTool(client))
+	registry.Register(NewSearchAndCacheTool(client))
+	registry.Register(NewSearchCacheTool(client))
+	registry.Register(NewSearchCoverageTool(client))
+	registry.Register(NewSearchAuthorsTool(client))
+	registry.Register(NewFindCrossPostsTool(client))
+	registry.Register(NewGetMessageByIndexTool(client))
+	registry.Register(NewMonthPreviewsTool(client))
+	registry.Register(NewParsePatchSubjectTool())
+	registry.Register(NewPatchSeriesTool(client))
+	registry.Register(NewCacheAuditTailTool(client))
+	registry.Register(NewCacheExportTool(client))
+	registry.Register(NewHelpTool(registry))
+	return nil
+}
+
+// RegisterAdminTools adds the tools that change server-wide settings or
+// cached data for every caller. They are only registered when the operator
+// opts in.
+func RegisterAdminTools(registry *Registry) error {
+	client, err := getClient()
+	if err != nil {
+		return fmt.Errorf("create marc client: %w", err)
+	}
+
+	registry.Register(NewCacheEvictTool(client))
+	registry.Register(NewCacheImportTool(client))
+	registry.Register(NewAdminReconfigureTool(client))
 	return nil
 }
 
@@ -35,3 +35,32 @@ func TestCloseAllowsReinit(t *testing.T) {
 		t.Fatalf("second Close() failed: %v", err)
 	}
 }
+
+func TestAdminToolsAreOptIn(t *testing.T) {
+	t.Setenv("MARC_CACHE_DB", filepath.Join(t.TempDir(), "cache.db"))
+	t.Cleanup(func() {
+		if err := Close(); err != nil {
+			t.Fatalf("Close() failed: %v", err)
+		}
+	})
+
+	registry, err := NewRegistryWithBuiltins()
+	if err != nil {
+		t.Fatalf("NewRegistryWithBuiltins() failed: %v", err)
+	}
+	admin := []string{"admin_reconfigure", "cache_evict", "cache_import"}
+	for _, name := range admin {
+		if _, ok := registry.tools[name]; ok {
+			t.Errorf("%s registered without opting in", name)
+		}
+	}
+
+	if err := RegisterAdminTools(registry); err != nil {
+		t.Fatalf("RegisterAdminTools() failed: %v", err)
+	}
+	for _, name := range admin {
+		if _, ok := registry.tools[name]; !ok {
+			t.Errorf("%s missing after RegisterAdminTools", name)
+		}
+	}
+}
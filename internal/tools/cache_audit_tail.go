@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+const (
+	defaultAuditTailLimit = 20
+	maxAuditTailLimit     = 500
+)
+
+type CacheAuditTailTool struct {
+	client *marc.Client
+}
+
+type CacheAuditTailInput struct {
+	Limit int `json:"limit,omitempty"`
+}
+
+func NewCacheAuditTailTool(client *marc.Client) Tool {
+	return &CacheAuditTailTool{client: client}
+}
+
+func (t *CacheAuditTailTool) Name() string {
+	return "cache_audit_tail"
+}
+
+func (t *CacheAuditTailTool) Description() string {
+	return "Show the most recent tool calls recorded in the audit log (requires MARC_AUDIT=true)"
+}
+
+func (t *CacheAuditTailTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"limit": map[string]any{
+				"type":        "integer",
+				"description": "Number of entries to return, newest first (default: 20, max: 500)",
+			},
+		},
+		"required":             []string{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CacheAuditTailTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req CacheAuditTailInput
+	if len(input) > 0 {
+		if err := json.Unmarshal(input, &req); err != nil {
+			return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+		}
+	}
+
+	if req.Limit < 0 {
+		return nil, fmt.Errorf("%w: limit must be positive", ErrInvalidArgument)
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultAuditTailLimit
+	}
+	req.Limit = min(req.Limit, maxAuditTailLimit)
+
+	entries, err := client.AuditTail(req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	return entries, nil
+}
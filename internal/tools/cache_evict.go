@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type CacheEvictTool struct {
+	client *marc.Client
+}
+
+type CacheEvictInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id,omitempty"`
+	Month     string `json:"month,omitempty"`
+}
+
+func NewCacheEvictTool(client *marc.Client) Tool {
+	return &CacheEvictTool{client: client}
+}
+
+func (t *CacheEvictTool) Name() string {
+	return "cache_evict"
+}
+
+func (t *CacheEvictTool) Description() string {
+	return "Remove a fetched message and/or a month listing from the local cache so the next request fetches and parses it again"
+}
+
+func (t *CacheEvictTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message whose cached content to remove",
+			},
+			"month": map[string]any{
+				"type":        "string",
+				"description": "Month in YYYYMM format whose cached listing to remove",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CacheEvictTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req CacheEvictInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" && req.Month == "" {
+		return nil, fmt.Errorf("%w: message_id or month is required", ErrInvalidArgument)
+	}
+
+	var removed int64
+	if req.MessageID != "" {
+		n, err := client.EvictMessage(req.List, req.MessageID)
+		if errors.Is(err, marc.ErrInvalidMessageID) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to evict message: %w", err)
+		}
+		removed += n
+	}
+	if req.Month != "" {
+		n, err := client.EvictMonth(req.List, req.Month)
+		if errors.Is(err, marc.ErrInvalidMonth) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to evict month: %w", err)
+		}
+		removed += n
+	}
+
+	return map[string]any{"removed": removed}, nil
+}
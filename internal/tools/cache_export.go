@@ -0,0 +1,53 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type CacheExportTool struct {
+	client *marc.Client
+}
+
+func NewCacheExportTool(client *marc.Client) Tool {
+	return &CacheExportTool{client: client}
+}
+
+func (t *CacheExportTool) Name() string {
+	return "cache_export"
+}
+
+func (t *CacheExportTool) Description() string {
+	return "Export the whole cache (mailing lists, listings, message contents and sources, documents, summaries) as a versioned JSON dump that cache_import restores on another server"
+}
+
+func (t *CacheExportTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type":                 "object",
+		"properties":           map[string]any{},
+		"required":             []string{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CacheExportTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	if len(input) > 0 {
+		var req struct{}
+		if err := json.Unmarshal(input, &req); err != nil {
+			return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+		}
+	}
+
+	var buf strings.Builder
+	if err := client.ExportCache(&buf); err != nil {
+		return nil, fmt.Errorf("failed to export cache: %w", err)
+	}
+
+	return TextResult(buf.String()), nil
+}
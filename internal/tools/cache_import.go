@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type CacheImportTool struct {
+	client *marc.Client
+}
+
+type CacheImportInput struct {
+	Data string `json:"data"`
+}
+
+func NewCacheImportTool(client *marc.Client) Tool {
+	return &CacheImportTool{client: client}
+}
+
+func (t *CacheImportTool) Name() string {
+	return "cache_import"
+}
+
+func (t *CacheImportTool) Description() string {
+	return "Restore a cache dump produced by cache_export. Imported entries replace cached ones with the same key; the search index is rebuilt afterwards."
+}
+
+func (t *CacheImportTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"data": map[string]any{
+				"type":        "string",
+				"description": "The JSON text returned by cache_export",
+			},
+		},
+		"required":             []string{"data"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CacheImportTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req CacheImportInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.Data == "" {
+		return nil, fmt.Errorf("%w: data is required", ErrInvalidArgument)
+	}
+
+	err := client.ImportCache(strings.NewReader(req.Data))
+	if errors.Is(err, marc.ErrUnsupportedExport) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to import cache: %w", err)
+	}
+
+	return map[string]any{
+		"imported": true,
+		"bytes":    len(req.Data),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type CachedMonthsTool struct {
+	client *marc.Client
+}
+
+type CachedMonthsInput struct {
+	List string `json:"list"`
+}
+
+func NewCachedMonthsTool(client *marc.Client) Tool {
+	return &CachedMonthsTool{client: client}
+}
+
+func (t *CachedMonthsTool) Name() string {
+	return "cached_months"
+}
+
+func (t *CachedMonthsTool) Description() string {
+	return "List the months (YYYYMM) that already have messages in the local cache for a mailing list"
+}
+
+func (t *CachedMonthsTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *CachedMonthsTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req CachedMonthsInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	months, err := client.CachedMonths(req.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cached months: %w", err)
+	}
+
+	return map[string]any{
+		"list":   req.List,
+		"months": months,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package tools
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// listCursor is the position encoded in list_messages' opaque next_cursor.
+type listCursor struct {
+	List  string `json:"l"`
+	Month string `json:"m"`
+	Page  int    `json:"p"`
+}
+
+func encodeCursor(c listCursor) string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (listCursor, error) {
+	var c listCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, errors.New("malformed cursor")
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, errors.New("malformed cursor")
+	}
+	if c.List == "" || c.Month == "" || c.Page < 1 {
+		return c, errors.New("incomplete cursor")
+	}
+	return c, nil
+}
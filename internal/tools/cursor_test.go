@@ -0,0 +1,80 @@
+package tools
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	want := listCursor{List: "linux-kernel", Month: "202602", Page: 3}
+
+	got, err := decodeCursor(encodeCursor(want))
+	if err != nil {
+		t.Fatalf("decode failed: %v", err)
+	}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestDecodeCursorRejectsGarbage(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "!!!"},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("nope"))},
+		{"missing page", encodeCursor(listCursor{List: "git", Month: "202602"})},
+		{"missing list", encodeCursor(listCursor{Month: "202602", Page: 2})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeCursor(tt.cursor); err == nil {
+				t.Errorf("expected error for %q", tt.cursor)
+			}
+		})
+	}
+}
+
+func TestListMessagesResultCursor(t *testing.T) {
+	t.Run("more pages yields cursor to next page", func(t *testing.T) {
+		res := newListMessagesResult(&marc.MessagePage{List: "git", Month: "202602", NextPage: 2})
+
+		cur, err := decodeCursor(res.NextCursor)
+		if err != nil {
+			t.Fatalf("decode failed: %v", err)
+		}
+		if cur != (listCursor{List: "git", Month: "202602", Page: 2}) {
+			t.Errorf("unexpected cursor: %+v", cur)
+		}
+	})
+
+	t.Run("last page has no cursor", func(t *testing.T) {
+		res := newListMessagesResult(&marc.MessagePage{List: "git", Month: "202602"})
+		if res.NextCursor != "" {
+			t.Errorf("expected no cursor on last page, got %q", res.NextCursor)
+		}
+		if res.Messages == nil {
+			t.Error("messages should be an empty list, not null")
+		}
+	})
+}
+
+func TestListMessagesResultEmpty(t *testing.T) {
+	empty, err := json.Marshal(newListMessagesResult(&marc.MessagePage{List: "git", Month: "202602", Empty: true, ParsedFrom: 120}))
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	if string(empty) != `{"messages":[],"empty":true,"parsed_from":120}` {
+		t.Errorf("unexpected empty month result: %s", empty)
+	}
+
+	broken := newListMessagesResult(&marc.MessagePage{List: "git", Month: "202602", ParsedFrom: 4096, Diagnostic: "no messages parsed"})
+	if broken.Empty || broken.Diagnostic != "no messages parsed" {
+		t.Errorf("expected the diagnostic to be passed on, got %+v", broken)
+	}
+}
@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxDeadline caps the deadline input; longer runs are bounded by the HTTP
+// write timeout anyway.
+const maxDeadline = 15 * time.Minute
+
+// deadlineSchema is the shared "deadline" input property of the multi-page
+// and batch tools. A non-empty note is appended to the description.
+func deadlineSchema(note string) map[string]any {
+	description := "Wall-clock budget as a Go duration (e.g., '5s'). When it runs out, the results gathered so far are returned with timed_out: true instead of an error. Requests in flight are cancelled."
+	if note != "" {
+		description += " " + note
+	}
+	return map[string]any{
+		"type":        "string",
+		"description": description,
+	}
+}
+
+// withDeadline bounds ctx by a deadline input; an empty deadline returns
+// ctx unchanged.
+func withDeadline(ctx context.Context, deadline string) (context.Context, context.CancelFunc, error) {
+	if deadline == "" {
+		return ctx, func() {}, nil
+	}
+
+	d, err := time.ParseDuration(deadline)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid deadline: %v", ErrInvalidArgument, err)
+	}
+	if d <= 0 || d > maxDeadline {
+		return nil, nil, fmt.Errorf("%w: deadline must be positive and at most %s", ErrInvalidArgument, maxDeadline)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return ctx, cancel, nil
+}
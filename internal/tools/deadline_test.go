@@ -0,0 +1,34 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithDeadline(t *testing.T) {
+	ctx, cancel, err := withDeadline(context.Background(), "")
+	if err != nil {
+		t.Fatalf("empty deadline failed: %v", err)
+	}
+	cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("expected no deadline when none is given")
+	}
+
+	ctx, cancel, err = withDeadline(context.Background(), "5s")
+	if err != nil {
+		t.Fatalf("withDeadline(5s) failed: %v", err)
+	}
+	defer cancel()
+	if d, ok := ctx.Deadline(); !ok || time.Until(d) > 5*time.Second {
+		t.Errorf("deadline = %v, %v; want within 5s", d, ok)
+	}
+
+	for _, bad := range []string{"soon", "0s", "-1s", "1h"} {
+		if _, _, err := withDeadline(context.Background(), bad); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("withDeadline(%q) error = %v, want ErrInvalidArgument", bad, err)
+		}
+	}
+}
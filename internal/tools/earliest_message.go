@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type EarliestMessageTool struct {
+	client *marc.Client
+}
+
+type EarliestMessageInput struct {
+	List string `json:"list"`
+}
+
+func NewEarliestMessageTool(client *marc.Client) Tool {
+	return &EarliestMessageTool{client: client}
+}
+
+func (t *EarliestMessageTool) Name() string {
+	return "earliest_message"
+}
+
+func (t *EarliestMessageTool) Description() string {
+	return "Find the oldest archived message of a mailing list"
+}
+
+func (t *EarliestMessageTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *EarliestMessageTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req EarliestMessageInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	msg, err := client.EarliestMessage(req.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find earliest message: %w", err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ExportMboxTool struct {
+	client *marc.Client
+}
+
+type ExportMboxInput struct {
+	List  string `json:"list"`
+	Month string `json:"month,omitempty"`
+}
+
+func NewExportMboxTool(client *marc.Client) Tool {
+	return &ExportMboxTool{client: client}
+}
+
+func (t *ExportMboxTool) Name() string {
+	return "export_mbox"
+}
+
+func (t *ExportMboxTool) Description() string {
+	return "Export every message of a month as an mbox file. With a progress token the mbox is streamed one message per progress notification; otherwise it is returned whole."
+}
+
+func (t *ExportMboxTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"month": map[string]any{
+				"type":        "string",
+				"description": "Month in YYYYMM format (e.g., '202602'). Defaults to current month.",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ExportMboxTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ExportMboxInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	month := req.Month
+	if month == "" {
+		month = time.Now().Format("200601")
+	}
+
+	report := progressFromContext(ctx)
+	if report == nil {
+		mbox, err := client.ExportMbox(req.List, month)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export mbox: %w", err)
+		}
+		return TextResult(mbox), nil
+	}
+
+	w := &progressWriter{report: report}
+	if err := client.ExportMboxTo(w, req.List, month); err != nil {
+		return nil, fmt.Errorf("failed to export mbox: %w", err)
+	}
+
+	return map[string]any{
+		"list":     req.List,
+		"month":    month,
+		"messages": w.chunks,
+		"bytes":    w.bytes,
+	}, nil
+}
+
+// progressWriter sends each Write as a progress notification. Sending does
+// not wait for the client, so a notification that cannot be delivered fails
+// the Write and aborts the export rather than leaving a hole in the mbox.
+type progressWriter struct {
+	report ProgressFunc
+	chunks int
+	bytes  int
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	if err := w.report(float64(w.chunks+1), string(p)); err != nil {
+		return 0, fmt.Errorf("send mbox chunk %d: %w", w.chunks+1, err)
+	}
+	w.chunks++
+	w.bytes += len(p)
+	return len(p), nil
+}
@@ -0,0 +1,30 @@
+package tools
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProgressWriterFailsOnUndeliveredChunk(t *testing.T) {
+	blocked := errors.New("notification channel blocked")
+	var sent []string
+	w := &progressWriter{report: func(progress float64, message string) error {
+		if len(sent) == 2 {
+			return blocked
+		}
+		sent = append(sent, message)
+		return nil
+	}}
+
+	for _, chunk := range []string{"From a\n", "From b\n"} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatalf("Write(%q) failed: %v", chunk, err)
+		}
+	}
+	if n, err := w.Write([]byte("From c\n")); !errors.Is(err, blocked) || n != 0 {
+		t.Errorf("Write() = %d, %v; want 0, %v", n, err, blocked)
+	}
+	if w.chunks != 2 || w.bytes != 14 {
+		t.Errorf("chunks = %d, bytes = %d; want only the 2 delivered chunks counted", w.chunks, w.bytes)
+	}
+}
@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ExtractQuotesTool struct {
+	client *marc.Client
+}
+
+type ExtractQuotesInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+}
+
+func NewExtractQuotesTool(client *marc.Client) Tool {
+	return &ExtractQuotesTool{client: client}
+}
+
+func (t *ExtractQuotesTool) Name() string {
+	return "extract_quotes"
+}
+
+func (t *ExtractQuotesTool) Description() string {
+	return "Fetch a message and list the quoted excerpts in its body, each with its quote depth and the \"On <date>, <author> wrote:\" attribution introducing it"
+}
+
+func (t *ExtractQuotesTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID from list_messages results; pasted forms like '#123', 'm=123' or a marc.info URL are accepted",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ExtractQuotesTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ExtractQuotesInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	quotes, err := client.ExtractQuotes(req.List, req.MessageID)
+	if errors.Is(err, marc.ErrInvalidMessageID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract quotes: %w", err)
+	}
+
+	return quotes, nil
+}
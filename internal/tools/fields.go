@@ -0,0 +1,59 @@
+package tools
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+// messageFields are the message keys list_messages can project to.
+var messageFields = []string{"id", "subject", "author", "date"}
+
+// fieldsSchema is the shared "fields" input property.
+func fieldsSchema() map[string]any {
+	return map[string]any{
+		"type":        "string",
+		"description": "Comma-separated message fields to return, e.g. 'id,subject' for cheap scanning. One or more of: " + strings.Join(messageFields, ", ") + ". Defaults to all fields.",
+	}
+}
+
+// parseFields validates a comma-separated field list. An empty list means
+// all fields and yields nil.
+func parseFields(s string) ([]string, error) {
+	var fields []string
+	for _, f := range strings.Split(s, ",") {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if f == "" || slices.Contains(fields, f) {
+			continue
+		}
+		if !slices.Contains(messageFields, f) {
+			return nil, fmt.Errorf("%w: unknown field %q, expected one of %s", ErrInvalidArgument, f, strings.Join(messageFields, ", "))
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// projectMessages keeps only the given fields of each message.
+func projectMessages(messages []marc.Message, fields []string) []map[string]any {
+	projected := make([]map[string]any, len(messages))
+	for i, m := range messages {
+		p := make(map[string]any, len(fields))
+		for _, f := range fields {
+			switch f {
+			case "id":
+				p[f] = m.ID
+			case "subject":
+				p[f] = m.Subject
+			case "author":
+				p[f] = m.Author
+			case "date":
+				p[f] = m.Date
+			}
+		}
+		projected[i] = p
+	}
+	return projected
+}
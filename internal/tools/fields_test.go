@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"", nil},
+		{"id,subject", []string{"id", "subject"}},
+		{" Subject , id ,,subject", []string{"subject", "id"}},
+		{"id,subject,author,date", []string{"id", "subject", "author", "date"}},
+	}
+	for _, tt := range tests {
+		got, err := parseFields(tt.in)
+		if err != nil {
+			t.Errorf("parseFields(%q) failed: %v", tt.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseFields(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := parseFields("id,body"); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("expected ErrInvalidArgument for unknown field, got %v", err)
+	}
+}
+
+func TestProjectMessages(t *testing.T) {
+	messages := []marc.Message{
+		{ID: "1", Subject: "[PATCH] fix", Author: "Alice", Date: "2026-02-01", List: "git", Patch: &marc.PatchInfo{Version: 1, Index: 1, Total: 1, IsPatch: true}},
+		{ID: "2", Subject: "Re: [PATCH] fix", Author: "Bob", Date: "2026-02-02", List: "git"},
+	}
+
+	projected := projectMessages(messages, []string{"id", "subject"})
+
+	raw, err := json.Marshal(projected)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	var got []map[string]any
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+
+	want := []map[string]any{
+		{"id": "1", "subject": "[PATCH] fix"},
+		{"id": "2", "subject": "Re: [PATCH] fix"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("projection = %v, want exactly %v", got, want)
+	}
+}
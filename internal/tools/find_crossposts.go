@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type FindCrossPostsTool struct {
+	client *marc.Client
+}
+
+func NewFindCrossPostsTool(client *marc.Client) Tool {
+	return &FindCrossPostsTool{client: client}
+}
+
+func (t *FindCrossPostsTool) Name() string {
+	return "find_crossposts"
+}
+
+func (t *FindCrossPostsTool) Description() string {
+	return "Find messages in the local cache that were cross-posted to several mailing lists, grouped by Message-ID header"
+}
+
+func (t *FindCrossPostsTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type":                 "object",
+		"properties":           map[string]any{},
+		"required":             []string{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *FindCrossPostsTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	if len(input) > 0 {
+		var req struct{}
+		if err := json.Unmarshal(input, &req); err != nil {
+			return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+		}
+	}
+
+	posts, err := client.FindCrossPosts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find cross-posts: %w", err)
+	}
+	if posts == nil {
+		posts = []marc.CrossPost{}
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,49 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Output formats accepted by tools returning message collections.
+const (
+	FormatJSON  = "json"
+	FormatJSONL = "jsonl"
+)
+
+// TextResult is a tool result that is already rendered; transports send it
+// as-is instead of JSON-encoding it.
+type TextResult string
+
+// formatSchema is the shared "format" input property.
+func formatSchema() map[string]any {
+	return map[string]any{
+		"type":        "string",
+		"description": "Output format: 'json' (default) or 'jsonl' for one compact JSON object per line",
+		"enum":        []string{FormatJSON, FormatJSONL},
+	}
+}
+
+func validateFormat(format string) error {
+	switch format {
+	case "", FormatJSON, FormatJSONL:
+		return nil
+	default:
+		return fmt.Errorf("%w: format must be one of %s, %s", ErrInvalidArgument, FormatJSON, FormatJSONL)
+	}
+}
+
+// toJSONLines renders each record as one compact JSON object per line.
+func toJSONLines[T any](records []T) (TextResult, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			return "", err
+		}
+	}
+	return TextResult(buf.String()), nil
+}
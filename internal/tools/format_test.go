@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+func TestToJSONLines(t *testing.T) {
+	messages := []marc.Message{
+		{ID: "1", Subject: "First <patch>", Author: "Alice", Date: "2026-02-01", List: "git"},
+		{ID: "2", Subject: "Second", Author: "Bob", Date: "2026-02-02", List: "git", Stale: true},
+	}
+
+	got, err := toJSONLines(messages)
+	if err != nil {
+		t.Fatalf("toJSONLines failed: %v", err)
+	}
+
+	want := `{"id":"1","subject":"First <patch>","author":"Alice","date":"2026-02-01","list":"git"}` + "\n" +
+		`{"id":"2","subject":"Second","author":"Bob","date":"2026-02-02","list":"git","stale":true}` + "\n"
+	if string(got) != want {
+		t.Errorf("toJSONLines() =\n%s\nwant\n%s", got, want)
+	}
+
+	empty, err := toJSONLines([]marc.Message{})
+	if err != nil {
+		t.Fatalf("toJSONLines failed: %v", err)
+	}
+	if empty != "" {
+		t.Errorf("expected empty output, got %q", empty)
+	}
+}
+
+func TestListMessagesResultJSONLines(t *testing.T) {
+	result := ListMessagesResult{
+		Messages:   []marc.Message{{ID: "1", Subject: "Hi", Author: "A", Date: "2026-02-01", List: "git"}},
+		NextCursor: "abc",
+	}
+
+	got, err := result.jsonLines()
+	if err != nil {
+		t.Fatalf("jsonLines failed: %v", err)
+	}
+
+	want := `{"id":"1","subject":"Hi","author":"A","date":"2026-02-01","list":"git"}` + "\n" +
+		`{"next_cursor":"abc"}` + "\n"
+	if string(got) != want {
+		t.Errorf("jsonLines() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestAllMessagesResultJSONLines(t *testing.T) {
+	all := &marc.AllMessagesResult{
+		Messages: []marc.Message{{ID: "1", Subject: "Hi", Author: "A", Date: "2026-02-01", List: "git"}},
+		TimedOut: true,
+		List:     "git",
+		Month:    "202602",
+		NextPage: 2,
+	}
+
+	got, err := newAllMessagesResult(all).render(nil, FormatJSONL)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+
+	cursor := encodeCursor(listCursor{List: "git", Month: "202602", Page: 2})
+	want := `{"id":"1","subject":"Hi","author":"A","date":"2026-02-01","list":"git"}` + "\n" +
+		`{"next_cursor":"` + cursor + `","timed_out":true}` + "\n"
+	if got != TextResult(want) {
+		t.Errorf("jsonl =\n%s\nwant\n%s", got, want)
+	}
+
+	// Without a timeout the envelope stays the same, minus the flag
+	all.TimedOut = false
+	complete, err := newAllMessagesResult(all).render(nil, FormatJSON)
+	if err != nil {
+		t.Fatalf("render failed: %v", err)
+	}
+	if r, ok := complete.(ListMessagesResult); !ok || r.TimedOut || r.NextCursor != "" || len(r.Messages) != 1 {
+		t.Errorf("complete result = %#v, want the single-page envelope without timed_out or next_cursor", complete)
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	for _, f := range []string{"", FormatJSON, FormatJSONL} {
+		if err := validateFormat(f); err != nil {
+			t.Errorf("validateFormat(%q) failed: %v", f, err)
+		}
+	}
+	if err := validateFormat("csv"); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type GetCatalogTool struct {
+	client *marc.Client
+}
+
+func NewGetCatalogTool(client *marc.Client) Tool {
+	return &GetCatalogTool{client: client}
+}
+
+func (t *GetCatalogTool) Name() string {
+	return "get_catalog"
+}
+
+func (t *GetCatalogTool) Description() string {
+	return "Get the whole marc.info list index in one call: every category in marc's order with its lists (name, description, posting address) and list counts"
+}
+
+func (t *GetCatalogTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type":                 "object",
+		"properties":           map[string]any{},
+		"required":             []string{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *GetCatalogTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	if len(input) > 0 {
+		var req struct{}
+		if err := json.Unmarshal(input, &req); err != nil {
+			return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+		}
+	}
+
+	catalog, err := client.GetCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get catalog: %w", err)
+	}
+
+	return catalog, nil
+}
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/andr1an/marc-mcp/internal/marc"
@@ -15,6 +16,10 @@ type GetMessageTool struct {
 type GetMessageInput struct {
 	List      string `json:"list"`
 	MessageID string `json:"message_id"`
+	DryRun    bool   `json:"dry_run,omitempty"`
+
+	PreserveWhitespace bool `json:"preserve_whitespace,omitempty"`
+	IncludeAttachments bool `json:"include_attachments,omitempty"`
 }
 
 func NewGetMessageTool(client *marc.Client) Tool {
@@ -39,7 +44,19 @@ func (t *GetMessageTool) InputSchema() map[string]any {
 			},
 			"message_id": map[string]any{
 				"type":        "string",
-				"description": "Message ID from list_messages results",
+				"description": "Message ID from list_messages results; pasted forms like '#123', 'm=123' or a marc.info URL are accepted",
+			},
+			"preserve_whitespace": map[string]any{
+				"type":        "boolean",
+				"description": "Return the body exactly as archived, keeping surrounding blank lines and trailing whitespace (default: trimmed)",
+			},
+			"include_attachments": map[string]any{
+				"type":        "boolean",
+				"description": "List the message's MIME attachments (filename, content type, decoded size) under attachments; costs a fetch of the raw source",
+			},
+			"dry_run": map[string]any{
+				"type":        "boolean",
+				"description": "Return the marc.info URL and resolved parameters without fetching anything",
 			},
 		},
 		"required":             []string{"list", "message_id"},
@@ -48,7 +65,7 @@ func (t *GetMessageTool) InputSchema() map[string]any {
 }
 
 func (t *GetMessageTool) Invoke(ctx context.Context, input []byte) (any, error) {
-	_ = ctx
+	client := t.client.WithContext(ctx)
 
 	var req GetMessageInput
 	if err := json.Unmarshal(input, &req); err != nil {
@@ -61,7 +78,21 @@ func (t *GetMessageTool) Invoke(ctx context.Context, input []byte) (any, error)
 		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
 	}
 
-	message, err := t.client.GetMessage(req.List, req.MessageID)
+	if req.DryRun {
+		plan, err := client.PlanGetMessage(req.List, req.MessageID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+		return plan, nil
+	}
+
+	message, err := client.GetMessageWithOptions(req.List, req.MessageID, marc.GetMessageOptions{
+		PreserveWhitespace: req.PreserveWhitespace,
+		IncludeAttachments: req.IncludeAttachments,
+	})
+	if errors.Is(err, marc.ErrInvalidMessageID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get message: %w", err)
 	}
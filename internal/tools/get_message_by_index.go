@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type GetMessageByIndexTool struct {
+	client *marc.Client
+}
+
+type GetMessageByIndexInput struct {
+	List  string `json:"list"`
+	Month string `json:"month,omitempty"`
+	Index int    `json:"index"`
+}
+
+func NewGetMessageByIndexTool(client *marc.Client) Tool {
+	return &GetMessageByIndexTool{client: client}
+}
+
+func (t *GetMessageByIndexTool) Name() string {
+	return "get_message_by_index"
+}
+
+func (t *GetMessageByIndexTool) Description() string {
+	return "Get the full content of the Nth message (1-based) on the first list_messages page of a month"
+}
+
+func (t *GetMessageByIndexTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"month": map[string]any{
+				"type":        "string",
+				"description": "Month in YYYYMM format (e.g., '202602'). Defaults to current month.",
+			},
+			"index": map[string]any{
+				"type":        "integer",
+				"description": "1-based position of the message on the month's first list_messages page (newest first)",
+			},
+		},
+		"required":             []string{"list", "index"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *GetMessageByIndexTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req GetMessageByIndexInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.Index < 1 {
+		return nil, fmt.Errorf("%w: index must be 1 or greater", ErrInvalidArgument)
+	}
+
+	msg, err := client.GetMessageByIndex(req.List, req.Month, req.Index)
+	if errors.Is(err, marc.ErrIndexOutOfRange) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return msg, nil
+}
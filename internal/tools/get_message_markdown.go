@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type GetMessageMarkdownTool struct {
+	client *marc.Client
+}
+
+type GetMessageMarkdownInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+}
+
+func NewGetMessageMarkdownTool(client *marc.Client) Tool {
+	return &GetMessageMarkdownTool{client: client}
+}
+
+func (t *GetMessageMarkdownTool) Name() string {
+	return "get_message_markdown"
+}
+
+func (t *GetMessageMarkdownTool) Description() string {
+	return "Get a message rendered as Markdown (subject heading, author/date/Message-ID, body with quotes as blockquotes), e.g. for pasting into an issue tracker"
+}
+
+func (t *GetMessageMarkdownTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID from list_messages results; pasted forms like '#123', 'm=123' or a marc.info URL are accepted",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *GetMessageMarkdownTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req GetMessageMarkdownInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	md, err := client.MessageMarkdown(req.List, req.MessageID)
+	if errors.Is(err, marc.ErrInvalidMessageID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to render message: %w", err)
+	}
+
+	return TextResult(md), nil
+}
@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type GetMessageSourceTool struct {
+	client *marc.Client
+}
+
+type GetMessageSourceInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+}
+
+func NewGetMessageSourceTool(client *marc.Client) Tool {
+	return &GetMessageSourceTool{client: client}
+}
+
+func (t *GetMessageSourceTool) Name() string {
+	return "get_message_source"
+}
+
+func (t *GetMessageSourceTool) Description() string {
+	return "Get the raw RFC822 source of a message exactly as transmitted, with original headers preserved"
+}
+
+func (t *GetMessageSourceTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID from list_messages results",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *GetMessageSourceTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req GetMessageSourceInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	source, err := client.GetMessageSource(req.List, req.MessageID)
+	if errors.Is(err, marc.ErrInvalidMessageID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message source: %w", err)
+	}
+
+	return map[string]any{
+		"list":       req.List,
+		"message_id": req.MessageID,
+		"source":     source,
+	}, nil
+}
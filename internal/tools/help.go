@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"context"
+	"sort"
+)
+
+// HelpTool describes every tool registered on a Registry, so its output
+// stays in sync as tools are added.
+type HelpTool struct {
+	registry *Registry
+}
+
+type ToolHelp struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Parameters  []ParameterHelp `json:"parameters"`
+	Example     ToolExample     `json:"example"`
+}
+
+type ParameterHelp struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required"`
+	Description string   `json:"description,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+}
+
+type ToolExample struct {
+	Tool      string         `json:"tool"`
+	Arguments map[string]any `json:"arguments"`
+}
+
+func NewHelpTool(registry *Registry) Tool {
+	return &HelpTool{registry: registry}
+}
+
+func (t *HelpTool) Name() string {
+	return "help"
+}
+
+func (t *HelpTool) Description() string {
+	return "Describe every available tool with its parameters and an example invocation"
+}
+
+func (t *HelpTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type":                 "object",
+		"properties":           map[string]any{},
+		"required":             []string{},
+		"additionalProperties": false,
+	}
+}
+
+func (t *HelpTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	_ = ctx
+	_ = input
+
+	infos := t.registry.List()
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+
+	out := make([]ToolHelp, 0, len(infos))
+	for _, info := range infos {
+		out = append(out, describeTool(info))
+	}
+	return out, nil
+}
+
+func describeTool(info ToolInfo) ToolHelp {
+	props, _ := info.InputSchema["properties"].(map[string]any)
+
+	required := make(map[string]bool)
+	if req, ok := info.InputSchema["required"].([]string); ok {
+		for _, name := range req {
+			required[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	// Required parameters first, then alphabetical
+	sort.Slice(names, func(i, j int) bool {
+		if required[names[i]] != required[names[j]] {
+			return required[names[i]]
+		}
+		return names[i] < names[j]
+	})
+
+	help := ToolHelp{
+		Name:        info.Name,
+		Description: info.Description,
+		Parameters:  make([]ParameterHelp, 0, len(names)),
+		Example:     ToolExample{Tool: info.Name, Arguments: make(map[string]any)},
+	}
+
+	for _, name := range names {
+		prop, _ := props[name].(map[string]any)
+		param := ParameterHelp{Name: name, Required: required[name]}
+		param.Type, _ = prop["type"].(string)
+		param.Description, _ = prop["description"].(string)
+		param.Enum, _ = prop["enum"].([]string)
+		help.Parameters = append(help.Parameters, param)
+
+		if param.Required {
+			help.Example.Arguments[name] = exampleValue(param)
+		}
+	}
+
+	return help
+}
+
+// exampleValue produces a placeholder argument of the parameter's type.
+func exampleValue(p ParameterHelp) any {
+	if len(p.Enum) > 0 {
+		return p.Enum[0]
+	}
+
+	switch p.Type {
+	case "integer", "number":
+		return 1
+	case "boolean":
+		return true
+	case "array":
+		return []string{"<" + p.Name + ">"}
+	default:
+		return "<" + p.Name + ">"
+	}
+}
@@ -0,0 +1,49 @@
+package tools
+
+import (
+	"context"
+	"testing"
+)
+
+func TestHelpListsEveryRegisteredTool(t *testing.T) {
+	r := NewRegistry()
+	r.Register(NewListMailingListsTool(nil))
+	r.Register(NewListMessagesTool(nil))
+	r.Register(NewGetMessageTool(nil))
+	r.Register(NewSearchMessagesTool(nil))
+	r.Register(NewHelpTool(r))
+
+	out, err := r.Invoke(context.Background(), "help", nil)
+	if err != nil {
+		t.Fatalf("help failed: %v", err)
+	}
+
+	help, ok := out.([]ToolHelp)
+	if !ok {
+		t.Fatalf("unexpected help output type %T", out)
+	}
+
+	byName := make(map[string]ToolHelp)
+	for _, h := range help {
+		byName[h.Name] = h
+	}
+	for _, info := range r.List() {
+		if _, ok := byName[info.Name]; !ok {
+			t.Errorf("tool %q missing from help output", info.Name)
+		}
+	}
+
+	search := byName["search_messages"]
+	if search.Example.Tool != "search_messages" {
+		t.Errorf("example tool = %q, want search_messages", search.Example.Tool)
+	}
+	if search.Example.Arguments["list"] != "<list>" || search.Example.Arguments["query"] != "<query>" {
+		t.Errorf("example should fill required arguments, got %v", search.Example.Arguments)
+	}
+	if _, ok := search.Example.Arguments["search_type"]; ok {
+		t.Error("example should only include required arguments")
+	}
+	if len(search.Parameters) == 0 || !search.Parameters[0].Required {
+		t.Errorf("required parameters should come first: %+v", search.Parameters)
+	}
+}
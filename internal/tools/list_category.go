@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ListCategoryTool struct {
+	client *marc.Client
+}
+
+type ListCategoryInput struct {
+	List string `json:"list"`
+}
+
+func NewListCategoryTool(client *marc.Client) Tool {
+	return &ListCategoryTool{client: client}
+}
+
+func (t *ListCategoryTool) Name() string {
+	return "list_category"
+}
+
+func (t *ListCategoryTool) Description() string {
+	return "Look up which category a mailing list belongs to"
+}
+
+func (t *ListCategoryTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list (e.g., 'git')",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ListCategoryTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ListCategoryInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	category, found, err := client.ListCategory(req.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up list category: %w", err)
+	}
+
+	result := map[string]any{
+		"list":  req.List,
+		"found": found,
+	}
+	if found {
+		result["category"] = category
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ListFacetsTool struct {
+	client *marc.Client
+}
+
+type ListFacetsInput struct {
+	List string `json:"list"`
+}
+
+func NewListFacetsTool(client *marc.Client) Tool {
+	return &ListFacetsTool{client: client}
+}
+
+func (t *ListFacetsTool) Name() string {
+	return "list_facets"
+}
+
+func (t *ListFacetsTool) Description() string {
+	return "Count the cached messages of a mailing list per author and per month (YYYYMM), for browsing. Each facet keeps its largest buckets only; nothing is fetched from marc.info"
+}
+
+func (t *ListFacetsTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ListFacetsTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ListFacetsInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	facets, err := client.ListFacets(req.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count facets: %w", err)
+	}
+
+	return map[string]any{
+		"list":      req.List,
+		"by_author": facets.ByAuthor,
+		"by_month":  facets.ByMonth,
+	}, nil
+}
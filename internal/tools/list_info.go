@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ListInfoTool struct {
+	client *marc.Client
+}
+
+type ListInfoInput struct {
+	List  string `json:"list"`
+	Month string `json:"month,omitempty"`
+}
+
+func NewListInfoTool(client *marc.Client) Tool {
+	return &ListInfoTool{client: client}
+}
+
+func (t *ListInfoTool) Name() string {
+	return "list_info"
+}
+
+func (t *ListInfoTool) Description() string {
+	return "Describe a mailing list: its category and how many messages marc.info shows per listing page, and whether that count was observed or assumed"
+}
+
+func (t *ListInfoTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"month": map[string]any{
+				"type":        "string",
+				"description": "Month in YYYYMM format to measure the page size on, if it is not known yet. Defaults to current month.",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ListInfoTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ListInfoInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	category, found, err := client.ListCategory(req.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up list category: %w", err)
+	}
+
+	result := map[string]any{
+		"list":  req.List,
+		"found": found,
+	}
+	if !found {
+		return result, nil
+	}
+	result["category"] = category
+
+	pageSize, detected, err := client.DetectPageSize(req.List, req.Month)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect page size: %w", err)
+	}
+	result["page_size"] = pageSize
+	result["page_size_detected"] = detected
+
+	return result, nil
+}
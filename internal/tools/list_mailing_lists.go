@@ -16,6 +16,7 @@ type ListMailingListsTool struct {
 type ListMailingListsInput struct {
 	Category string `json:"category,omitempty"`
 	Filter   string `json:"filter,omitempty"`
+	Grouped  bool   `json:"grouped,omitempty"`
 }
 
 func NewListMailingListsTool(client *marc.Client) Tool {
@@ -27,7 +28,7 @@ func (t *ListMailingListsTool) Name() string {
 }
 
 func (t *ListMailingListsTool) Description() string {
-	return "List all available mailing lists from marc.info, optionally filtered by category or name regex"
+	return "List all available mailing lists from marc.info with their category, and description and posting address where marc.info shows them, optionally filtered by category or name regex. Set grouped to nest the lists under their categories."
 }
 
 func (t *ListMailingListsTool) InputSchema() map[string]any {
@@ -42,6 +43,10 @@ func (t *ListMailingListsTool) InputSchema() map[string]any {
 				"type":        "string",
 				"description": "Filter list names by regular expression (e.g., 'git.*', '^linux', 'kernel')",
 			},
+			"grouped": map[string]any{
+				"type":        "boolean",
+				"description": "Return [{category, list_count, lists}] sorted by category and list name instead of a flat array. Lists without a category go under '(uncategorized)'.",
+			},
 		},
 		"required":             []string{},
 		"additionalProperties": false,
@@ -49,7 +54,7 @@ func (t *ListMailingListsTool) InputSchema() map[string]any {
 }
 
 func (t *ListMailingListsTool) Invoke(ctx context.Context, input []byte) (any, error) {
-	_ = ctx
+	client := t.client.WithContext(ctx)
 
 	var req ListMailingListsInput
 	if len(input) > 0 {
@@ -67,7 +72,7 @@ func (t *ListMailingListsTool) Invoke(ctx context.Context, input []byte) (any, e
 		filterRe = re
 	}
 
-	lists, err := t.client.ListMailingLists()
+	lists, err := client.ListMailingLists()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list mailing lists: %w", err)
 	}
@@ -92,5 +97,8 @@ func (t *ListMailingListsTool) Invoke(ctx context.Context, input []byte) (any, e
 		lists = filtered
 	}
 
+	if req.Grouped {
+		return marc.GroupMailingLists(lists), nil
+	}
 	return lists, nil
 }
@@ -13,10 +13,20 @@ type ListMessagesTool struct {
 }
 
 type ListMessagesInput struct {
-	List  string `json:"list"`
-	Month string `json:"month,omitempty"`
-	Page  int    `json:"page,omitempty"`
-	Limit int    `json:"limit,omitempty"`
+	List     string `json:"list"`
+	Month    string `json:"month,omitempty"`
+	Page     int    `json:"page,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+	AllPages bool   `json:"all_pages,omitempty"`
+	DryRun   bool   `json:"dry_run,omitempty"`
+	Cursor   string `json:"cursor,omitempty"`
+	Format   string `json:"format,omitempty"`
+	Fields   string `json:"fields,omitempty"`
+	Order    string `json:"order,omitempty"`
+	Deadline string `json:"deadline,omitempty"`
+
+	ExcludeAuthors  []string `json:"exclude_authors,omitempty"`
+	ExcludeSubjects []string `json:"exclude_subjects,omitempty"`
 }
 
 func NewListMessagesTool(client *marc.Client) Tool {
@@ -28,7 +38,7 @@ func (t *ListMessagesTool) Name() string {
 }
 
 func (t *ListMessagesTool) Description() string {
-	return "List messages from a mailing list. Defaults to current month. Each page has ~30 messages."
+	return "List messages from a mailing list. Defaults to current month. The page size varies by list (see list_info); pass the returned next_cursor back as cursor to continue."
 }
 
 func (t *ListMessagesTool) InputSchema() map[string]any {
@@ -45,38 +55,259 @@ func (t *ListMessagesTool) InputSchema() map[string]any {
 			},
 			"page": map[string]any{
 				"type":        "integer",
-				"description": "Page number (1-based, default: 1). Each page has ~30 messages.",
+				"description": "Page number (1-based, default: 1). Use list_info for the number of messages per page.",
 			},
 			"limit": map[string]any{
 				"type":        "integer",
 				"description": "Maximum number of messages to return from this page (default: all)",
 			},
+			"all_pages": map[string]any{
+				"type":        "boolean",
+				"description": "Fetch every page of the month starting at page, streaming each page as a progress notification when the client supplies a progress token. limit then caps the total across pages.",
+			},
+			"exclude_authors": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": "Drop messages whose author contains any of these substrings (case-insensitive), e.g. 'noreply@'",
+			},
+			"exclude_subjects": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": "Drop messages whose subject contains any of these substrings (case-insensitive)",
+			},
+			"dry_run": map[string]any{
+				"type":        "boolean",
+				"description": "Return the marc.info URL and resolved parameters without fetching anything",
+			},
+			"cursor": map[string]any{
+				"type":        "string",
+				"description": "Opaque next_cursor from a previous call. Takes precedence over list, month and page.",
+			},
+			"format": map[string]any{
+				"type":        "string",
+				"description": "Output format: 'json' (default) or 'jsonl' for one compact JSON object per message; in jsonl a final {\"next_cursor\": ...} line follows when there are more pages",
+				"enum":        []string{FormatJSON, FormatJSONL},
+			},
+			"deadline": deadlineSchema("Only used with all_pages; next_cursor then resumes at the first page not fetched."),
+			"fields":   fieldsSchema(),
+			"order":    orderSchema("'desc' (default) for newest first or 'asc' for oldest first. Orders the messages returned; pages and limit still count from the newest."),
 		},
-		"required":             []string{"list"},
+		"required":             []string{},
 		"additionalProperties": false,
 	}
 }
 
 func (t *ListMessagesTool) Invoke(ctx context.Context, input []byte) (any, error) {
-	_ = ctx
+	client := t.client.WithContext(ctx)
 
 	var req ListMessagesInput
 	if err := json.Unmarshal(input, &req); err != nil {
 		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
 	}
+	if req.Cursor != "" {
+		cur, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+		req.List, req.Month, req.Page = cur.List, cur.Month, cur.Page
+	}
 	if req.List == "" {
 		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
 	}
+	if err := validateFormat(req.Format); err != nil {
+		return nil, err
+	}
+	if err := validateOrder(req.Order); err != nil {
+		return nil, err
+	}
+	fields, err := parseFields(req.Fields)
+	if err != nil {
+		return nil, err
+	}
 
-	messages, err := t.client.ListMessagesWithOptions(marc.ListMessagesOptions{
+	opts := marc.ListMessagesOptions{
 		List:  req.List,
 		Month: req.Month,
 		Page:  req.Page,
 		Limit: req.Limit,
-	})
+
+		ExcludeAuthors:  req.ExcludeAuthors,
+		ExcludeSubjects: req.ExcludeSubjects,
+
+		Order: req.Order,
+	}
+
+	if req.DryRun {
+		plan, err := client.PlanListMessages(opts)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+		}
+		return plan, nil
+	}
+
+	if req.AllPages {
+		listCtx, cancel, err := withDeadline(ctx, req.Deadline)
+		if err != nil {
+			return nil, err
+		}
+		defer cancel()
+
+		all, err := client.ListAllMessagesContext(listCtx, opts, pageProgress(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list messages: %w", err)
+		}
+		return newAllMessagesResult(all).render(fields, req.Format)
+	}
+
+	page, err := client.ListMessagesPage(opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list messages: %w", err)
 	}
+	return newListMessagesResult(page).render(fields, req.Format)
+}
+
+// ListMessagesResult is the list_messages result for a single page and for
+// all_pages alike. TimedOut is only set by all_pages with a deadline.
+type ListMessagesResult struct {
+	Messages   []marc.Message `json:"messages"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	TimedOut   bool           `json:"timed_out,omitempty"`
+
+	// List and RequestedList are only set when the listing came from
+	// another list than the one asked for; see marc.MessagePage.
+	List          string `json:"list,omitempty"`
+	RequestedList string `json:"requested_list,omitempty"`
+	Empty         bool   `json:"empty,omitempty"`
+	ParsedFrom    int    `json:"parsed_from,omitempty"`
+	Diagnostic    string `json:"diagnostic,omitempty"`
+}
+
+// newListMessagesResult wraps a page with the cursor for the page after it.
+// The cursor carries the resolved month, so a request that defaulted to the
+// current month keeps paging through that month.
+func newListMessagesResult(page *marc.MessagePage) ListMessagesResult {
+	result := ListMessagesResult{
+		Messages:   page.Messages,
+		Empty:      page.Empty,
+		ParsedFrom: page.ParsedFrom,
+		Diagnostic: page.Diagnostic,
+	}
+	if result.Messages == nil {
+		result.Messages = []marc.Message{}
+	}
+	if page.RequestedList != "" {
+		result.List, result.RequestedList = page.List, page.RequestedList
+	}
+	if page.NextPage > 0 {
+		result.NextCursor = encodeCursor(listCursor{
+			List:  page.List,
+			Month: page.Month,
+			Page:  page.NextPage,
+		})
+	}
+	return result
+}
+
+// newAllMessagesResult wraps the messages of all_pages. When the deadline
+// ran out before the last page, the cursor resumes at the first page not
+// fetched, since how many pages remain is not known.
+func newAllMessagesResult(all *marc.AllMessagesResult) ListMessagesResult {
+	result := ListMessagesResult{Messages: all.Messages, TimedOut: all.TimedOut}
+	if result.Messages == nil {
+		result.Messages = []marc.Message{}
+	}
+	if all.TimedOut {
+		result.NextCursor = encodeCursor(listCursor{List: all.List, Month: all.Month, Page: all.NextPage})
+	}
+	return result
+}
 
-	return messages, nil
+// render restricts r to fields, if any, and renders it in format.
+func (r ListMessagesResult) render(fields []string, format string) (any, error) {
+	if fields == nil {
+		if format == FormatJSONL {
+			return r.jsonLines()
+		}
+		return r, nil
+	}
+
+	projected := ProjectedMessagesResult{
+		Messages:      projectMessages(r.Messages, fields),
+		NextCursor:    r.NextCursor,
+		TimedOut:      r.TimedOut,
+		List:          r.List,
+		RequestedList: r.RequestedList,
+		Empty:         r.Empty,
+		ParsedFrom:    r.ParsedFrom,
+		Diagnostic:    r.Diagnostic,
+	}
+	if format == FormatJSONL {
+		return messagesJSONLines(projected.Messages, projected.NextCursor, projected.TimedOut)
+	}
+	return projected, nil
+}
+
+// ProjectedMessagesResult is ListMessagesResult restricted to the
+// requested fields.
+type ProjectedMessagesResult struct {
+	Messages      []map[string]any `json:"messages"`
+	NextCursor    string           `json:"next_cursor,omitempty"`
+	TimedOut      bool             `json:"timed_out,omitempty"`
+	List          string           `json:"list,omitempty"`
+	RequestedList string           `json:"requested_list,omitempty"`
+	Empty         bool             `json:"empty,omitempty"`
+	ParsedFrom    int              `json:"parsed_from,omitempty"`
+	Diagnostic    string           `json:"diagnostic,omitempty"`
+}
+
+// jsonLines renders one message per line, followed by a next_cursor line
+// when there are more pages.
+func (r ListMessagesResult) jsonLines() (TextResult, error) {
+	return messagesJSONLines(r.Messages, r.NextCursor, r.TimedOut)
+}
+
+// messagesJSONLines renders one message per line and, when there are more
+// pages or the deadline ran out, a final line with next_cursor and
+// timed_out.
+func messagesJSONLines[T any](messages []T, nextCursor string, timedOut bool) (TextResult, error) {
+	lines, err := toJSONLines(messages)
+	if err != nil || (nextCursor == "" && !timedOut) {
+		return lines, err
+	}
+
+	trailer := map[string]any{}
+	if nextCursor != "" {
+		trailer["next_cursor"] = nextCursor
+	}
+	if timedOut {
+		trailer["timed_out"] = true
+	}
+	tail, err := toJSONLines([]map[string]any{trailer})
+	if err != nil {
+		return "", err
+	}
+	return lines + tail, nil
+}
+
+// pageProgress forwards each fetched page as a progress notification whose
+// message carries the page's messages as JSON. It returns nil when the
+// caller did not ask for progress. The result holds every message as well,
+// so a notification that could not be delivered is not an error.
+func pageProgress(ctx context.Context) marc.PageFunc {
+	report := progressFromContext(ctx)
+	if report == nil {
+		return nil
+	}
+
+	return func(page int, messages []marc.Message) error {
+		payload, err := json.Marshal(map[string]any{
+			"page":     page,
+			"messages": messages,
+		})
+		if err != nil {
+			return err
+		}
+		_ = report(float64(page), string(payload))
+		return nil
+	}
 }
@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ListMonthsTool struct {
+	client *marc.Client
+}
+
+type ListMonthsInput struct {
+	List string `json:"list"`
+}
+
+func NewListMonthsTool(client *marc.Client) Tool {
+	return &ListMonthsTool{client: client}
+}
+
+func (t *ListMonthsTool) Name() string {
+	return "list_months"
+}
+
+func (t *ListMonthsTool) Description() string {
+	return "List the months (YYYYMM) marc.info has archived for a mailing list, oldest first"
+}
+
+func (t *ListMonthsTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ListMonthsTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ListMonthsInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	months, err := client.ListMonths(req.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list months: %w", err)
+	}
+
+	return map[string]any{
+		"list":   req.List,
+		"months": months,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ListsActiveSinceTool struct {
+	client *marc.Client
+}
+
+type ListsActiveSinceInput struct {
+	Since string   `json:"since"`
+	Lists []string `json:"lists,omitempty"`
+}
+
+func NewListsActiveSinceTool(client *marc.Client) Tool {
+	return &ListsActiveSinceTool{client: client}
+}
+
+func (t *ListsActiveSinceTool) Name() string {
+	return "lists_active_since"
+}
+
+func (t *ListsActiveSinceTool) Description() string {
+	return "List the mailing lists with messages in the current month dated on or after a timestamp. Checks every list with cached listings, plus any named in lists."
+}
+
+func (t *ListsActiveSinceTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"since": map[string]any{
+				"type":        "string",
+				"description": "RFC3339 timestamp, e.g. the time of the previous poll ('2026-02-24T08:00:00Z'). Compared by day, since listings only carry dates.",
+			},
+			"lists": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": "Additional lists to check even if nothing of theirs is cached yet; their current month is fetched",
+			},
+		},
+		"required":             []string{"since"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ListsActiveSinceTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ListsActiveSinceInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.Since == "" {
+		return nil, fmt.Errorf("%w: since is required", ErrInvalidArgument)
+	}
+	since, err := time.Parse(time.RFC3339, req.Since)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid since: %v", ErrInvalidArgument, err)
+	}
+
+	lists, err := client.ListsActiveSince(since, req.Lists...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check list activity: %w", err)
+	}
+
+	return lists, nil
+}
@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type MessageAncestryTool struct {
+	client *marc.Client
+}
+
+type MessageAncestryInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+}
+
+func NewMessageAncestryTool(client *marc.Client) Tool {
+	return &MessageAncestryTool{client: client}
+}
+
+func (t *MessageAncestryTool) Name() string {
+	return "message_ancestry"
+}
+
+func (t *MessageAncestryTool) Description() string {
+	return "Get a message together with every ancestor in its References chain, oldest first"
+}
+
+func (t *MessageAncestryTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID from list_messages results",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *MessageAncestryTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req MessageAncestryInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	ancestry, err := client.Ancestry(req.List, req.MessageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message ancestry: %w", err)
+	}
+
+	return ancestry, nil
+}
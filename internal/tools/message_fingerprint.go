@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type MessageFingerprintTool struct {
+	client *marc.Client
+}
+
+type MessageFingerprintInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+}
+
+func NewMessageFingerprintTool(client *marc.Client) Tool {
+	return &MessageFingerprintTool{client: client}
+}
+
+func (t *MessageFingerprintTool) Name() string {
+	return "message_fingerprint"
+}
+
+func (t *MessageFingerprintTool) Description() string {
+	return "Get a stable SHA-256 fingerprint of a message (Message-ID, From, Subject and Date headers plus the trimmed body) for deduplication and change detection"
+}
+
+func (t *MessageFingerprintTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID from list_messages results",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *MessageFingerprintTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req MessageFingerprintInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	fingerprint, err := client.MessageFingerprint(req.List, req.MessageID)
+	if errors.Is(err, marc.ErrInvalidMessageID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint message: %w", err)
+	}
+
+	return map[string]any{
+		"list":        req.List,
+		"message_id":  req.MessageID,
+		"fingerprint": fingerprint,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+// maxPreviewLines caps the lines parameter of month_previews.
+const maxPreviewLines = 10
+
+type MonthPreviewsTool struct {
+	client *marc.Client
+}
+
+type MonthPreviewsInput struct {
+	List       string `json:"list"`
+	Month      string `json:"month,omitempty"`
+	Lines      int    `json:"lines,omitempty"`
+	CachedOnly bool   `json:"cached_only,omitempty"`
+	Deadline   string `json:"deadline,omitempty"`
+}
+
+func NewMonthPreviewsTool(client *marc.Client) Tool {
+	return &MonthPreviewsTool{client: client}
+}
+
+func (t *MonthPreviewsTool) Name() string {
+	return "month_previews"
+}
+
+func (t *MonthPreviewsTool) Description() string {
+	return "List a month of messages with a short preview of each body (its first non-quoted lines), to scan a month without fetching messages one by one. Uncached bodies are fetched unless cached_only is set."
+}
+
+func (t *MonthPreviewsTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"month": map[string]any{
+				"type":        "string",
+				"description": "Month in YYYYMM format (e.g., '202602'). Defaults to current month.",
+			},
+			"lines": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("Number of body lines per preview (default 1, at most %d)", maxPreviewLines),
+			},
+			"cached_only": map[string]any{
+				"type":        "boolean",
+				"description": "Use only the local cache: nothing is fetched, and messages without a cached body get an empty preview",
+			},
+			"deadline": deadlineSchema(""),
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *MonthPreviewsTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req MonthPreviewsInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.Lines < 0 || req.Lines > maxPreviewLines {
+		return nil, fmt.Errorf("%w: lines must be between 1 and %d", ErrInvalidArgument, maxPreviewLines)
+	}
+	if req.Lines == 0 {
+		req.Lines = 1
+	}
+
+	ctx, cancel, err := withDeadline(ctx, req.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	result, err := client.MonthPreviewsContext(ctx, req.List, req.Month, req.Lines, req.CachedOnly)
+	if errors.Is(err, marc.ErrInvalidMonth) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview messages: %w", err)
+	}
+
+	return result, nil
+}
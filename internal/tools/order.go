@@ -0,0 +1,26 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+// orderSchema is the shared "order" input property; description says what
+// an omitted order means for the tool.
+func orderSchema(description string) map[string]any {
+	return map[string]any{
+		"type":        "string",
+		"description": description,
+		"enum":        []string{marc.OrderDesc, marc.OrderAsc},
+	}
+}
+
+func validateOrder(order string) error {
+	switch order {
+	case "", marc.OrderDesc, marc.OrderAsc:
+		return nil
+	default:
+		return fmt.Errorf("%w: order must be one of %s, %s", ErrInvalidArgument, marc.OrderDesc, marc.OrderAsc)
+	}
+}
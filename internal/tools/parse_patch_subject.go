@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ParsePatchSubjectTool struct{}
+
+type ParsePatchSubjectInput struct {
+	Subject string `json:"subject"`
+}
+
+type ParsePatchSubjectResult struct {
+	Subject string `json:"subject"`
+	// Patch is null when the subject carries no patch series tag.
+	Patch *marc.PatchInfo `json:"patch"`
+}
+
+func NewParsePatchSubjectTool() Tool {
+	return &ParsePatchSubjectTool{}
+}
+
+func (t *ParsePatchSubjectTool) Name() string {
+	return "parse_patch_subject"
+}
+
+func (t *ParsePatchSubjectTool) Description() string {
+	return "Parse patch series metadata (version, N/M position) from a subject such as '[PATCH v3 2/5] ...'. No requests are made."
+}
+
+func (t *ParsePatchSubjectTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"subject": map[string]any{
+				"type":        "string",
+				"description": "Message subject, e.g. '[RFC PATCH v2 3/7] mm: rework reclaim'",
+			},
+		},
+		"required":             []string{"subject"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ParsePatchSubjectTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	_ = ctx
+
+	var req ParsePatchSubjectInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.Subject == "" {
+		return nil, fmt.Errorf("%w: subject is required", ErrInvalidArgument)
+	}
+
+	result := ParsePatchSubjectResult{Subject: req.Subject}
+	if info, ok := marc.ParsePatchSubject(req.Subject); ok {
+		result.Patch = &info
+	}
+	return result, nil
+}
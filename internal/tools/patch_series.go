@@ -0,0 +1,67 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type PatchSeriesTool struct {
+	client *marc.Client
+}
+
+type PatchSeriesInput struct {
+	List  string `json:"list"`
+	Month string `json:"month,omitempty"`
+}
+
+func NewPatchSeriesTool(client *marc.Client) Tool {
+	return &PatchSeriesTool{client: client}
+}
+
+func (t *PatchSeriesTool) Name() string {
+	return "patch_series"
+}
+
+func (t *PatchSeriesTool) Description() string {
+	return "Group a month's patches into series ([PATCH vN M/T]), ordered cover letter first, flagging series with missing parts. Fetches every page of the month."
+}
+
+func (t *PatchSeriesTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"month": map[string]any{
+				"type":        "string",
+				"description": "Month in YYYYMM format (e.g., '202602'). Defaults to current month.",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *PatchSeriesTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req PatchSeriesInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	series, err := client.PatchSeries(req.List, req.Month)
+	if err != nil {
+		return nil, fmt.Errorf("failed to group patch series: %w", err)
+	}
+
+	return series, nil
+}
@@ -0,0 +1,21 @@
+package tools
+
+import "context"
+
+// ProgressFunc reports incremental progress for a long-running tool call.
+// It returns an error when the report could not be delivered.
+type ProgressFunc func(progress float64, message string) error
+
+type progressContextKey struct{}
+
+// WithProgress attaches a progress reporter to ctx. Transports set this when
+// the caller asked for progress notifications.
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressContextKey{}, fn)
+}
+
+// progressFromContext returns the reporter attached to ctx, or nil.
+func progressFromContext(ctx context.Context) ProgressFunc {
+	fn, _ := ctx.Value(progressContextKey{}).(ProgressFunc)
+	return fn
+}
@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+// defaultRecentLimit is how many messages recent_across_lists returns when
+// no limit is given.
+const defaultRecentLimit = 20
+
+type RecentAcrossListsTool struct {
+	client *marc.Client
+}
+
+type RecentAcrossListsInput struct {
+	Lists []string `json:"lists"`
+	Limit int      `json:"limit,omitempty"`
+}
+
+func NewRecentAcrossListsTool(client *marc.Client) Tool {
+	return &RecentAcrossListsTool{client: client}
+}
+
+func (t *RecentAcrossListsTool) Name() string {
+	return "recent_across_lists"
+}
+
+func (t *RecentAcrossListsTool) Description() string {
+	return "Get the most recent messages across several mailing lists, merged newest first, like an inbox of followed lists. Lists that fail are skipped and named under failed."
+}
+
+func (t *RecentAcrossListsTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"lists": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": "Names of the mailing lists to merge",
+			},
+			"limit": map[string]any{
+				"type":        "integer",
+				"description": fmt.Sprintf("Number of messages to return (default %d)", defaultRecentLimit),
+			},
+		},
+		"required":             []string{"lists"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *RecentAcrossListsTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req RecentAcrossListsInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if len(req.Lists) == 0 {
+		return nil, fmt.Errorf("%w: lists is required", ErrInvalidArgument)
+	}
+	for _, l := range req.Lists {
+		if l == "" {
+			return nil, fmt.Errorf("%w: lists must not contain empty names", ErrInvalidArgument)
+		}
+	}
+	if req.Limit < 0 {
+		return nil, fmt.Errorf("%w: limit must be positive", ErrInvalidArgument)
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultRecentLimit
+	}
+
+	recent, err := client.RecentAcrossLists(req.Lists, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent messages: %w", err)
+	}
+
+	return recent, nil
+}
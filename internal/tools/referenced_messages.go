@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ReferencedMessagesTool struct {
+	client *marc.Client
+}
+
+type ReferencedMessagesInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+}
+
+func NewReferencedMessagesTool(client *marc.Client) Tool {
+	return &ReferencedMessagesTool{client: client}
+}
+
+func (t *ReferencedMessagesTool) Name() string {
+	return "referenced_messages"
+}
+
+func (t *ReferencedMessagesTool) Description() string {
+	return "Follow the cross-references in a message body: marc.info message URLs and <...@...> Message-IDs mentioned in the text are resolved and the referenced messages returned, in order of first mention. Unresolvable references are skipped."
+}
+
+func (t *ReferencedMessagesTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID from list_messages results",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ReferencedMessagesTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ReferencedMessagesInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	messages, err := client.FindReferencedMessages(req.List, req.MessageID)
+	if errors.Is(err, marc.ErrInvalidMessageID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find referenced messages: %w", err)
+	}
+
+	return messages, nil
+}
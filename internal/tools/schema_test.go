@@ -6,9 +6,46 @@ func TestMarcToolsHaveBasicSchemaShape(t *testing.T) {
 	// Constructors do not use client for metadata methods.
 	all := []Tool{
 		NewListMailingListsTool(nil),
+		NewGetCatalogTool(nil),
 		NewListMessagesTool(nil),
 		NewGetMessageTool(nil),
 		NewSearchMessagesTool(nil),
+		NewGetMessageSourceTool(nil),
+		NewMessageFingerprintTool(nil),
+		NewMessageStatsTool(nil),
+		NewThreadByRootAuthorTool(nil),
+		NewCachedMonthsTool(nil),
+		NewVerifyMonthTool(nil),
+		NewListFacetsTool(nil),
+		NewMessageAncestryTool(nil),
+		NewReferencedMessagesTool(nil),
+		NewThreadDocumentTool(nil),
+		NewSearchCacheTool(nil),
+		NewSearchAuthorsTool(nil),
+		NewGetMessageByIndexTool(nil),
+		NewMonthPreviewsTool(nil),
+		NewListCategoryTool(nil),
+		NewExportMboxTool(nil),
+		NewListMonthsTool(nil),
+		NewEarliestMessageTool(nil),
+		NewCacheAuditTailTool(nil),
+		NewCacheExportTool(nil),
+		NewCacheImportTool(nil),
+		NewAdminReconfigureTool(nil),
+		NewFindCrossPostsTool(nil),
+		NewListsActiveSinceTool(nil),
+		NewRecentAcrossListsTool(nil),
+		NewWatchListTool(nil),
+		NewParsePatchSubjectTool(),
+		NewPatchSeriesTool(nil),
+		NewListInfoTool(nil),
+		NewSearchAndCacheTool(nil),
+		NewSearchCoverageTool(nil),
+		NewCacheEvictTool(nil),
+		NewGetMessageMarkdownTool(nil),
+		NewExtractQuotesTool(nil),
+		NewThreadHTMLTool(nil),
+		NewHelpTool(NewRegistry()),
 	}
 
 	r := NewRegistry()
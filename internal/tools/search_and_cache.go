@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type SearchAndCacheTool struct {
+	client *marc.Client
+}
+
+type SearchAndCacheInput struct {
+	List       string `json:"list"`
+	Query      string `json:"query"`
+	SearchType string `json:"search_type,omitempty"`
+	Deadline   string `json:"deadline,omitempty"`
+}
+
+func NewSearchAndCacheTool(client *marc.Client) Tool {
+	return &SearchAndCacheTool{client: client}
+}
+
+func (t *SearchAndCacheTool) Name() string {
+	return "search_and_cache"
+}
+
+func (t *SearchAndCacheTool) Description() string {
+	return "Search a mailing list on marc.info and fetch the full content of every hit into the local cache, so search_cache covers them afterwards"
+}
+
+func (t *SearchAndCacheTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list to search",
+			},
+			"query": map[string]any{
+				"type":        "string",
+				"description": "Search query string",
+			},
+			"search_type": map[string]any{
+				"type":        "string",
+				"description": "Type of search: 's' for subject (default), 'a' for author, 'b' for body",
+				"enum":        []string{"s", "a", "b"},
+			},
+			"deadline": deadlineSchema(""),
+		},
+		"required":             []string{"list", "query"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *SearchAndCacheTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req SearchAndCacheInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.Query == "" {
+		return nil, fmt.Errorf("%w: query is required", ErrInvalidArgument)
+	}
+	if req.SearchType == "" {
+		req.SearchType = "s"
+	}
+	if req.SearchType != "s" && req.SearchType != "a" && req.SearchType != "b" {
+		return nil, fmt.Errorf("%w: search_type must be one of s, a, b", ErrInvalidArgument)
+	}
+
+	ctx, cancel, err := withDeadline(ctx, req.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	result, err := client.SearchAndCacheContext(ctx, req.List, req.Query, req.SearchType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+	if result.Messages == nil {
+		result.Messages = []marc.Message{}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type SearchAuthorsTool struct {
+	client *marc.Client
+}
+
+type SearchAuthorsInput struct {
+	Author string `json:"author"`
+	List   string `json:"list,omitempty"`
+}
+
+func NewSearchAuthorsTool(client *marc.Client) Tool {
+	return &SearchAuthorsTool{client: client}
+}
+
+func (t *SearchAuthorsTool) Name() string {
+	return "search_authors"
+}
+
+func (t *SearchAuthorsTool) Description() string {
+	return "Find cached messages by author name or address across all mailing lists, newest first. Only searches the local cache."
+}
+
+func (t *SearchAuthorsTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"author": map[string]any{
+				"type":        "string",
+				"description": "Part of the author's name or email address (case-insensitive)",
+			},
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Restrict the search to one mailing list",
+			},
+		},
+		"required":             []string{"author"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *SearchAuthorsTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req SearchAuthorsInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.Author == "" {
+		return nil, fmt.Errorf("%w: author is required", ErrInvalidArgument)
+	}
+
+	messages, err := client.SearchByAuthor(req.Author, req.List)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search authors: %w", err)
+	}
+
+	return messages, nil
+}
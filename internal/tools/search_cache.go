@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type SearchCacheTool struct {
+	client *marc.Client
+}
+
+type SearchCacheInput struct {
+	Query       string `json:"query"`
+	List        string `json:"list,omitempty"`
+	ExcludeList string `json:"exclude_list,omitempty"`
+	Order       string `json:"order,omitempty"`
+}
+
+func NewSearchCacheTool(client *marc.Client) Tool {
+	return &SearchCacheTool{client: client}
+}
+
+func (t *SearchCacheTool) Name() string {
+	return "search_cache"
+}
+
+func (t *SearchCacheTool) Description() string {
+	return "Full-text search over messages already fetched into the local cache, across all mailing lists"
+}
+
+func (t *SearchCacheTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"query": map[string]any{
+				"type":        "string",
+				"description": "Search terms (SQLite FTS5 syntax)",
+			},
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Only return messages from this mailing list",
+			},
+			"exclude_list": map[string]any{
+				"type":        "string",
+				"description": "Leave out messages from this mailing list. Ignored when it equals list.",
+			},
+			"order": orderSchema("Sort matches by date: 'desc' for newest first or 'asc' for oldest first. Defaults to relevance order."),
+		},
+		"required":             []string{"query"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *SearchCacheTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req SearchCacheInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.Query == "" {
+		return nil, fmt.Errorf("%w: query is required", ErrInvalidArgument)
+	}
+	if err := validateOrder(req.Order); err != nil {
+		return nil, err
+	}
+
+	messages, err := client.SearchCached(req.Query, req.List, req.ExcludeList, req.Order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search cache: %w", err)
+	}
+
+	return messages, nil
+}
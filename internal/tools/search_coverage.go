@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type SearchCoverageTool struct {
+	client *marc.Client
+}
+
+type SearchCoverageInput struct {
+	List       string `json:"list"`
+	Query      string `json:"query"`
+	SearchType string `json:"search_type,omitempty"`
+}
+
+func NewSearchCoverageTool(client *marc.Client) Tool {
+	return &SearchCoverageTool{client: client}
+}
+
+func (t *SearchCoverageTool) Name() string {
+	return "search_coverage"
+}
+
+func (t *SearchCoverageTool) Description() string {
+	return "Compare how many messages match a query in the local cache with the approximate number marc.info finds live, with a coverage ratio, to decide whether to prefetch more before relying on search_cache"
+}
+
+func (t *SearchCoverageTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"query": map[string]any{
+				"type":        "string",
+				"description": "Search query string",
+			},
+			"search_type": map[string]any{
+				"type":        "string",
+				"description": "Type of live search: 's' for subject (default), 'a' for author, 'b' for body",
+				"enum":        []string{"s", "a", "b"},
+			},
+		},
+		"required":             []string{"list", "query"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *SearchCoverageTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req SearchCoverageInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.Query == "" {
+		return nil, fmt.Errorf("%w: query is required", ErrInvalidArgument)
+	}
+	if req.SearchType == "" {
+		req.SearchType = "s"
+	}
+	if req.SearchType != "s" && req.SearchType != "a" && req.SearchType != "b" {
+		return nil, fmt.Errorf("%w: search_type must be one of s, a, b", ErrInvalidArgument)
+	}
+
+	coverage, err := client.SearchCoverage(req.List, req.Query, req.SearchType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to measure search coverage: %w", err)
+	}
+
+	return coverage, nil
+}
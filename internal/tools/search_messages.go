@@ -16,6 +16,10 @@ type SearchMessagesInput struct {
 	List       string `json:"list"`
 	Query      string `json:"query"`
 	SearchType string `json:"search_type,omitempty"`
+	DryRun     bool   `json:"dry_run,omitempty"`
+
+	SearchTypes []string `json:"search_types,omitempty"`
+	Format      string   `json:"format,omitempty"`
 }
 
 func NewSearchMessagesTool(client *marc.Client) Tool {
@@ -47,6 +51,16 @@ func (t *SearchMessagesTool) InputSchema() map[string]any {
 				"description": "Type of search: 's' for subject (default), 'a' for author, 'b' for body",
 				"enum":        []string{"s", "a", "b"},
 			},
+			"search_types": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string", "enum": []string{"s", "a", "b"}},
+				"description": "Run several search types and merge the results by message ID, e.g. ['s','b'] for subject or body. Overrides search_type.",
+			},
+			"dry_run": map[string]any{
+				"type":        "boolean",
+				"description": "Return the marc.info URL and resolved parameters without fetching anything",
+			},
+			"format": formatSchema(),
 		},
 		"required":             []string{"list", "query"},
 		"additionalProperties": false,
@@ -54,7 +68,7 @@ func (t *SearchMessagesTool) InputSchema() map[string]any {
 }
 
 func (t *SearchMessagesTool) Invoke(ctx context.Context, input []byte) (any, error) {
-	_ = ctx
+	client := t.client.WithContext(ctx)
 
 	var req SearchMessagesInput
 	if err := json.Unmarshal(input, &req); err != nil {
@@ -69,14 +83,37 @@ func (t *SearchMessagesTool) Invoke(ctx context.Context, input []byte) (any, err
 	if req.SearchType == "" {
 		req.SearchType = "s"
 	}
-	if req.SearchType != "s" && req.SearchType != "a" && req.SearchType != "b" {
-		return nil, fmt.Errorf("%w: search_type must be one of s, a, b", ErrInvalidArgument)
+	searchTypes := req.SearchTypes
+	if len(searchTypes) == 0 {
+		searchTypes = []string{req.SearchType}
+	}
+	for _, st := range searchTypes {
+		if st != "s" && st != "a" && st != "b" {
+			return nil, fmt.Errorf("%w: search_type must be one of s, a, b", ErrInvalidArgument)
+		}
+	}
+	if err := validateFormat(req.Format); err != nil {
+		return nil, err
+	}
+
+	if req.DryRun {
+		if len(req.SearchTypes) == 0 {
+			return client.PlanSearch(req.List, req.Query, req.SearchType), nil
+		}
+		plans := make([]*marc.DryRun, len(searchTypes))
+		for i, st := range searchTypes {
+			plans[i] = client.PlanSearch(req.List, req.Query, st)
+		}
+		return plans, nil
 	}
 
-	messages, err := t.client.Search(req.List, req.Query, req.SearchType)
+	messages, err := client.SearchMulti(req.List, req.Query, searchTypes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search messages: %w", err)
 	}
 
+	if req.Format == FormatJSONL {
+		return toJSONLines(messages)
+	}
 	return messages, nil
 }
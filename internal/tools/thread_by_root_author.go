@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ThreadByRootAuthorTool struct {
+	client *marc.Client
+}
+
+type ThreadByRootAuthorInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+}
+
+func NewThreadByRootAuthorTool(client *marc.Client) Tool {
+	return &ThreadByRootAuthorTool{client: client}
+}
+
+func (t *ThreadByRootAuthorTool) Name() string {
+	return "thread_by_root_author"
+}
+
+func (t *ThreadByRootAuthorTool) Description() string {
+	return "Get only the messages of a thread sent by its root message's author, oldest first, to follow how they refined a proposal. Authors are matched by email address, ignoring display-name variations."
+}
+
+func (t *ThreadByRootAuthorTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID of the thread root, from list_messages results",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ThreadByRootAuthorTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ThreadByRootAuthorInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	messages, err := client.AuthorMessagesInThread(req.List, req.MessageID)
+	if errors.Is(err, marc.ErrInvalidMessageID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get root author messages: %w", err)
+	}
+
+	return messages, nil
+}
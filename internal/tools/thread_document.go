@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ThreadDocumentTool struct {
+	client *marc.Client
+}
+
+type ThreadDocumentInput struct {
+	List        string `json:"list"`
+	MessageID   string `json:"message_id"`
+	StripQuotes bool   `json:"strip_quotes,omitempty"`
+}
+
+func NewThreadDocumentTool(client *marc.Client) Tool {
+	return &ThreadDocumentTool{client: client}
+}
+
+func (t *ThreadDocumentTool) Name() string {
+	return "thread_document"
+}
+
+func (t *ThreadDocumentTool) Description() string {
+	return "Get a whole thread as one text document, messages in thread order separated by '--- Message N: <subject> by <author> on <date> ---' lines"
+}
+
+func (t *ThreadDocumentTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID of the thread's first message",
+			},
+			"strip_quotes": map[string]any{
+				"type":        "boolean",
+				"description": "Drop quoted ('>') lines from message bodies (default: false)",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ThreadDocumentTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ThreadDocumentInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	doc, err := client.ThreadDocument(req.List, req.MessageID, req.StripQuotes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build thread document: %w", err)
+	}
+
+	return map[string]any{
+		"list":       req.List,
+		"message_id": req.MessageID,
+		"document":   doc,
+	}, nil
+}
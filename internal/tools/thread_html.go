@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type ThreadHTMLTool struct {
+	client *marc.Client
+}
+
+type ThreadHTMLInput struct {
+	List      string `json:"list"`
+	MessageID string `json:"message_id"`
+}
+
+func NewThreadHTMLTool(client *marc.Client) Tool {
+	return &ThreadHTMLTool{client: client}
+}
+
+func (t *ThreadHTMLTool) Name() string {
+	return "thread_html"
+}
+
+func (t *ThreadHTMLTool) Description() string {
+	return "Get a whole thread as a self-contained HTML page for sharing, one section per message with subject, author, date and body"
+}
+
+func (t *ThreadHTMLTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"message_id": map[string]any{
+				"type":        "string",
+				"description": "Message ID of the thread's first message",
+			},
+		},
+		"required":             []string{"list", "message_id"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *ThreadHTMLTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req ThreadHTMLInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+	if req.MessageID == "" {
+		return nil, fmt.Errorf("%w: message_id is required", ErrInvalidArgument)
+	}
+
+	page, err := client.ThreadHTML(req.List, req.MessageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render thread: %w", err)
+	}
+
+	return TextResult(page), nil
+}
@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+type VerifyMonthTool struct {
+	client *marc.Client
+}
+
+type VerifyMonthInput struct {
+	List  string `json:"list"`
+	Month string `json:"month,omitempty"`
+}
+
+func NewVerifyMonthTool(client *marc.Client) Tool {
+	return &VerifyMonthTool{client: client}
+}
+
+func (t *VerifyMonthTool) Name() string {
+	return "verify_month"
+}
+
+func (t *VerifyMonthTool) Description() string {
+	return "Check a cached month against marc.info: fetch the live listing and report message IDs only listed live (cache stale or incomplete) and only cached (deleted upstream or kept too long). Read-only: the cache is not updated."
+}
+
+func (t *VerifyMonthTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"month": map[string]any{
+				"type":        "string",
+				"description": "Month in YYYYMM format (e.g., '202602'). Defaults to current month.",
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *VerifyMonthTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req VerifyMonthInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	result, err := client.VerifyMonth(req.List, req.Month)
+	if errors.Is(err, marc.ErrInvalidMonth) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArgument, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify month: %w", err)
+	}
+
+	return result, nil
+}
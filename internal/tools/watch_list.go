@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+)
+
+// Polling bounds for watch_list. The default wait stays under the default
+// WRITE_TIMEOUT so the response is not cut off.
+const (
+	defaultWatchInterval = 30 * time.Second
+	minWatchInterval     = 5 * time.Second
+	defaultWatchDuration = 50 * time.Second
+	maxWatchDuration     = 15 * time.Minute
+)
+
+type WatchListTool struct {
+	client *marc.Client
+}
+
+type WatchListInput struct {
+	List        string   `json:"list"`
+	Baseline    []string `json:"baseline,omitempty"`
+	Interval    string   `json:"interval,omitempty"`
+	MaxDuration string   `json:"max_duration,omitempty"`
+}
+
+func NewWatchListTool(client *marc.Client) Tool {
+	return &WatchListTool{client: client}
+}
+
+func (t *WatchListTool) Name() string {
+	return "watch_list"
+}
+
+func (t *WatchListTool) Description() string {
+	return "Block until new messages appear in a mailing list's current month, polling marc.info, and return them. Returns an empty list if nothing arrives within max_duration."
+}
+
+func (t *WatchListTool) InputSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"list": map[string]any{
+				"type":        "string",
+				"description": "Name of the mailing list",
+			},
+			"baseline": map[string]any{
+				"type":        "array",
+				"items":       map[string]any{"type": "string"},
+				"description": "IDs of messages already seen; anything else counts as new. Default: the messages listed when the call starts.",
+			},
+			"interval": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("Polling interval as a Go duration (default %s, minimum %s)", defaultWatchInterval, minWatchInterval),
+			},
+			"max_duration": map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("How long to wait at most, as a Go duration (default %s, maximum %s). Keep it below the server's WRITE_TIMEOUT.", defaultWatchDuration, maxWatchDuration),
+			},
+		},
+		"required":             []string{"list"},
+		"additionalProperties": false,
+	}
+}
+
+func (t *WatchListTool) Invoke(ctx context.Context, input []byte) (any, error) {
+	client := t.client.WithContext(ctx)
+
+	var req WatchListInput
+	if err := json.Unmarshal(input, &req); err != nil {
+		return nil, fmt.Errorf("%w: invalid JSON body: %v", ErrInvalidArgument, err)
+	}
+	if req.List == "" {
+		return nil, fmt.Errorf("%w: list is required", ErrInvalidArgument)
+	}
+
+	interval := defaultWatchInterval
+	if req.Interval != "" {
+		d, err := time.ParseDuration(req.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid interval: %v", ErrInvalidArgument, err)
+		}
+		if d < minWatchInterval {
+			return nil, fmt.Errorf("%w: interval must be at least %s", ErrInvalidArgument, minWatchInterval)
+		}
+		interval = d
+	}
+
+	maxDuration := defaultWatchDuration
+	if req.MaxDuration != "" {
+		d, err := time.ParseDuration(req.MaxDuration)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid max_duration: %v", ErrInvalidArgument, err)
+		}
+		if d <= 0 || d > maxWatchDuration {
+			return nil, fmt.Errorf("%w: max_duration must be between 0 and %s", ErrInvalidArgument, maxWatchDuration)
+		}
+		maxDuration = d
+	}
+
+	messages, err := client.WaitForNewMessages(ctx, req.List, req.Baseline, interval, maxDuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch list: %w", err)
+	}
+
+	return messages, nil
+}
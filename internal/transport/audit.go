@@ -0,0 +1,85 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/marc"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// AuditRecorder persists audit entries. *marc.Client implements it.
+type AuditRecorder interface {
+	RecordAudit(entry marc.AuditEntry) error
+}
+
+// AuditMiddleware records every tool call with its arguments, outcome and
+// duration. Recording failures are logged and never affect the call result.
+func AuditMiddleware(recorder AuditRecorder, logger *slog.Logger) ToolMiddleware {
+	return func(toolName string, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			start := time.Now()
+			result, err := next(ctx, req)
+
+			entry := marc.AuditEntry{
+				Time:       start,
+				Tool:       toolName,
+				Arguments:  auditArguments(req),
+				Success:    err == nil && (result == nil || !result.IsError),
+				DurationMS: time.Since(start).Milliseconds(),
+			}
+			switch {
+			case err != nil:
+				entry.Error = err.Error()
+			case result != nil && result.IsError:
+				entry.Error = resultText(result)
+			}
+
+			if recErr := recorder.RecordAudit(entry); recErr != nil {
+				logger.Warn("failed to record audit entry", "tool", toolName, "error", recErr)
+			}
+
+			return result, err
+		}
+	}
+}
+
+// maxAuditArgument caps how much of a string argument is recorded, so a
+// payload such as cache_import's data does not land in the audit log whole.
+const maxAuditArgument = 256
+
+// auditArguments returns the call's arguments as JSON, with string values
+// longer than maxAuditArgument truncated.
+func auditArguments(req mcp.CallToolRequest) json.RawMessage {
+	args := req.GetArguments()
+	if args == nil {
+		return json.RawMessage("{}")
+	}
+
+	recorded := make(map[string]any, len(args))
+	for k, v := range args {
+		if s, ok := v.(string); ok && len(s) > maxAuditArgument {
+			v = fmt.Sprintf("%s... [%d bytes truncated]", strings.ToValidUTF8(s[:maxAuditArgument], ""), len(s)-maxAuditArgument)
+		}
+		recorded[k] = v
+	}
+	b, err := json.Marshal(recorded)
+	if err != nil {
+		return json.RawMessage("{}")
+	}
+	return b
+}
+
+func resultText(result *mcp.CallToolResult) string {
+	for _, c := range result.Content {
+		if text, ok := c.(mcp.TextContent); ok {
+			return text.Text
+		}
+	}
+	return ""
+}
@@ -0,0 +1,33 @@
+package transport
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/andr1an/marc-mcp/internal/logctx"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// CorrelationMiddleware gives every tool call a short correlation ID and
+// stores a logger tagged with it in the context (see logctx), so the log
+// lines of concurrent calls can be told apart.
+func CorrelationMiddleware(logger *slog.Logger) ToolMiddleware {
+	return func(toolName string, next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ctx = logctx.WithCorrelationID(ctx, logger, logctx.NewCorrelationID())
+			reqLogger := logctx.FromContext(ctx)
+
+			start := time.Now()
+			reqLogger.Debug("tool call started", "tool", toolName)
+			result, err := next(ctx, req)
+			reqLogger.Debug("tool call finished", "tool", toolName,
+				"error", err != nil || (result != nil && result.IsError),
+				"duration", time.Since(start),
+			)
+
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/andr1an/marc-mcp/internal/logctx"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestCorrelationMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	handler := CorrelationMiddleware(logger)("test_tool", func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		reqLogger := logctx.FromContext(ctx)
+		if reqLogger == nil {
+			t.Fatal("expected a request logger in the context")
+		}
+		reqLogger.Info("working", "step", 1)
+		reqLogger.Info("working", "step", 2)
+		return mcp.NewToolResultText("ok"), nil
+	})
+
+	for range 2 {
+		if _, err := handler(context.Background(), mcp.CallToolRequest{}); err != nil {
+			t.Fatalf("handler failed: %v", err)
+		}
+	}
+
+	// Each call logs start, two steps and finish under its own ID
+	var ids []string
+	for line := range strings.Lines(buf.String()) {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid log line %q: %v", line, err)
+		}
+		id, _ := record[logctx.CorrelationKey].(string)
+		if id == "" {
+			t.Fatalf("log record %v lacks a correlation ID", record)
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) != 8 {
+		t.Fatalf("expected 8 log records, got %d:\n%s", len(ids), buf.String())
+	}
+	for i := range 4 {
+		if ids[i] != ids[0] || ids[4+i] != ids[4] {
+			t.Errorf("records of one call carry different IDs: %v", ids)
+		}
+	}
+	if ids[0] == ids[4] {
+		t.Errorf("two calls share correlation ID %s", ids[0])
+	}
+}
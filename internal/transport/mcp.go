@@ -3,6 +3,7 @@ package transport
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/andr1an/marc-mcp/internal/tools"
@@ -14,7 +15,29 @@ type MCPHandler struct {
 	streamable http.Handler
 }
 
-func NewMCPHandler(registry *tools.Registry, version string) *MCPHandler {
+// ToolMiddleware wraps the handler of a single tool. Middlewares are applied
+// in the order given, the first one being outermost.
+type ToolMiddleware func(toolName string, next server.ToolHandlerFunc) server.ToolHandlerFunc
+
+type Option func(*handlerOptions)
+
+type handlerOptions struct {
+	middleware []ToolMiddleware
+}
+
+// WithToolMiddleware wraps every registered tool handler with mw.
+func WithToolMiddleware(mw ToolMiddleware) Option {
+	return func(o *handlerOptions) {
+		o.middleware = append(o.middleware, mw)
+	}
+}
+
+func NewMCPHandler(registry *tools.Registry, version string, opts ...Option) *MCPHandler {
+	var o handlerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	mcpServer := server.NewMCPServer(
 		"marc-mcp",
 		version,
@@ -23,7 +46,7 @@ func NewMCPHandler(registry *tools.Registry, version string) *MCPHandler {
 
 	for _, info := range registry.List() {
 		toolName := info.Name
-		mcpServer.AddTool(toMCPTool(info), func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		var handler server.ToolHandlerFunc = func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 			input := map[string]any{}
 			if args := req.GetArguments(); args != nil {
 				input = args
@@ -34,16 +57,30 @@ func NewMCPHandler(registry *tools.Registry, version string) *MCPHandler {
 				return mcp.NewToolResultError("invalid tool arguments"), nil
 			}
 
+			if req.Params.Meta != nil && req.Params.Meta.ProgressToken != nil {
+				ctx = tools.WithProgress(ctx, progressNotifier(ctx, req.Params.Meta.ProgressToken))
+			}
+
 			result, err := registry.Invoke(ctx, toolName, rawInput)
 			if err != nil {
 				return mcp.NewToolResultError(err.Error()), nil
 			}
 
+			// Pre-rendered output (e.g. JSON Lines) is passed through verbatim
+			if text, ok := result.(tools.TextResult); ok {
+				return mcp.NewToolResultText(string(text)), nil
+			}
+
 			return &mcp.CallToolResult{
 				Content:           []mcp.Content{mcp.NewTextContent(toJSONString(result))},
 				StructuredContent: toStructuredContent(result),
 			}, nil
-		})
+		}
+
+		for i := len(o.middleware) - 1; i >= 0; i-- {
+			handler = o.middleware[i](toolName, handler)
+		}
+		mcpServer.AddTool(toMCPTool(info), handler)
 	}
 
 	return &MCPHandler{streamable: server.NewStreamableHTTPServer(mcpServer, server.WithStateLess(true))}
@@ -53,6 +90,24 @@ func (h *MCPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	h.streamable.ServeHTTP(w, r)
 }
 
+// progressNotifier sends notifications/progress for token back to the client
+// that issued the current request. Sending does not wait for the client: a
+// notification that does not fit the session's queue is dropped and its
+// error returned.
+func progressNotifier(ctx context.Context, token mcp.ProgressToken) tools.ProgressFunc {
+	return func(progress float64, message string) error {
+		srv := server.ServerFromContext(ctx)
+		if srv == nil {
+			return errors.New("no server in context")
+		}
+		return srv.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+			"progressToken": token,
+			"progress":      progress,
+			"message":       message,
+		})
+	}
+}
+
 func toMCPTool(info tools.ToolInfo) mcp.Tool {
 	schemaBytes, err := json.Marshal(info.InputSchema)
 	if err != nil {
@@ -3,11 +3,15 @@ package transport
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/andr1an/marc-mcp/internal/marc"
 	"github.com/andr1an/marc-mcp/internal/tools"
 	"github.com/mark3labs/mcp-go/client"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -70,6 +74,52 @@ func TestMCPHandlerListTools(t *testing.T) {
 	}
 }
 
+func TestMCPHandlerPassesTextResultVerbatim(t *testing.T) {
+	lines := "{\"id\":\"1\"}\n{\"id\":\"2\"}\n"
+
+	reg := tools.NewRegistry()
+	reg.Register(&testTool{
+		name:        "jsonl_tool",
+		description: "test",
+		schema:      map[string]any{"type": "object", "properties": map[string]any{}, "additionalProperties": false},
+		result:      tools.TextResult(lines),
+	})
+
+	s := httptest.NewServer(NewMCPHandler(reg, "test"))
+	defer s.Close()
+
+	c, err := client.NewStreamableHttpClient(s.URL)
+	if err != nil {
+		t.Fatalf("create client failed: %v", err)
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	if err := c.Start(ctx); err != nil {
+		t.Fatalf("start client failed: %v", err)
+	}
+	if _, err := c.Initialize(ctx, mcp.InitializeRequest{Params: mcp.InitializeParams{ProtocolVersion: mcp.LATEST_PROTOCOL_VERSION, ClientInfo: mcp.Implementation{Name: "test", Version: "1.0.0"}}}); err != nil {
+		t.Fatalf("initialize failed: %v", err)
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Name = "jsonl_tool"
+	res, err := c.CallTool(ctx, req)
+	if err != nil {
+		t.Fatalf("call tool failed: %v", err)
+	}
+
+	if len(res.Content) != 1 {
+		t.Fatalf("expected one content item, got %d", len(res.Content))
+	}
+	text, ok := res.Content[0].(mcp.TextContent)
+	if !ok || text.Text != lines {
+		t.Errorf("expected verbatim JSON Lines, got %#v", res.Content[0])
+	}
+}
+
 func TestToMCPToolFallbackSchema(t *testing.T) {
 	tool := toMCPTool(tools.ToolInfo{
 		Name:        "bad_schema_tool",
@@ -98,3 +148,80 @@ func TestMCPHandlerMethodNotAllowed(t *testing.T) {
 		t.Fatalf("expected 404, got %d", rec.Code)
 	}
 }
+
+type fakeRecorder struct {
+	entries []marc.AuditEntry
+	err     error
+}
+
+func (r *fakeRecorder) RecordAudit(e marc.AuditEntry) error {
+	r.entries = append(r.entries, e)
+	return r.err
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	ok := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultText("fine"), nil
+	}
+	failing := func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return mcp.NewToolResultError("boom"), nil
+	}
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]any{"list": "git"}
+
+	t.Run("records outcome", func(t *testing.T) {
+		rec := &fakeRecorder{}
+		mw := AuditMiddleware(rec, slog.New(slog.DiscardHandler))
+
+		if _, err := mw("list_messages", ok)(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := mw("get_message", failing)(context.Background(), req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(rec.entries) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(rec.entries))
+		}
+		if e := rec.entries[0]; !e.Success || e.Tool != "list_messages" || string(e.Arguments) != `{"list":"git"}` {
+			t.Errorf("unexpected success entry: %+v", e)
+		}
+		if e := rec.entries[1]; e.Success || e.Error != "boom" {
+			t.Errorf("unexpected error entry: %+v", e)
+		}
+	})
+
+	t.Run("truncates long arguments", func(t *testing.T) {
+		rec := &fakeRecorder{}
+		mw := AuditMiddleware(rec, slog.New(slog.DiscardHandler))
+
+		big := mcp.CallToolRequest{}
+		big.Params.Arguments = map[string]any{"data": strings.Repeat("x", 10000)}
+		if _, err := mw("cache_import", ok)(context.Background(), big); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		var args map[string]string
+		if err := json.Unmarshal(rec.entries[0].Arguments, &args); err != nil {
+			t.Fatalf("invalid arguments %s: %v", rec.entries[0].Arguments, err)
+		}
+		want := strings.Repeat("x", maxAuditArgument) + "... [9744 bytes truncated]"
+		if args["data"] != want {
+			t.Errorf("recorded data = %q, want %q", args["data"], want)
+		}
+	})
+
+	t.Run("recorder failure does not break the call", func(t *testing.T) {
+		rec := &fakeRecorder{err: errors.New("disk full")}
+		mw := AuditMiddleware(rec, slog.New(slog.DiscardHandler))
+
+		res, err := mw("list_messages", ok)(context.Background(), req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res == nil || res.IsError {
+			t.Fatalf("expected successful result, got %+v", res)
+		}
+	})
+}